@@ -0,0 +1,100 @@
+package causes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+)
+
+// Attach attaches secondary to primary as a suppressed error, e.g. a
+// rollback failure that happened while handling primary. Unlike wrapping,
+// the suppressed error is not part of the chain, so errors.Is and errors.As
+// only match primary.
+//
+// If either error is nil, the other is returned.
+func Attach(primary, secondary error) error {
+	if primary == nil {
+		return secondary
+	}
+	if secondary == nil {
+		return primary
+	}
+
+	if s, ok := primary.(*suppressedError); ok {
+		return &suppressedError{
+			err:        s.err,
+			suppressed: append(slices.Clip(s.suppressed), secondary),
+		}
+	}
+
+	return &suppressedError{
+		err:        primary,
+		suppressed: []error{secondary},
+	}
+}
+
+// Suppressed returns the errors attached to err with Attach.
+func Suppressed(err error) []error {
+	var s *suppressedError
+	if !errors.As(err, &s) {
+		return nil
+	}
+
+	return slices.Clone(s.suppressed)
+}
+
+type suppressedError struct {
+	err        error
+	suppressed []error
+}
+
+func (e *suppressedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *suppressedError) Unwrap() error {
+	return e.err
+}
+
+// Format implements fmt.Formatter. The %+v verb prints the suppressed errors
+// after the primary error.
+func (e *suppressedError) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%+v", e.err)
+			for _, err := range e.suppressed {
+				fmt.Fprintf(f, "\nSuppressed: %+v", err)
+			}
+			return
+		}
+
+		fallthrough
+	case 's':
+		io.WriteString(f, e.Error())
+	case 'q':
+		fmt.Fprintf(f, "%q", e.Error())
+	}
+}
+
+// LogValue logs the primary error, with the messages of the suppressed
+// errors under the "suppressed" key.
+func (e *suppressedError) LogValue() slog.Value {
+	suppressed := make([]string, len(e.suppressed))
+	for i, err := range e.suppressed {
+		suppressed[i] = err.Error()
+	}
+
+	v := slog.AnyValue(e.err).Resolve()
+	if v.Kind() == slog.KindGroup {
+		attrs := append(slices.Clip(v.Group()), slog.Any("suppressed", suppressed))
+		return slog.GroupValue(attrs...)
+	}
+
+	return slog.GroupValue(
+		slog.String("message", e.err.Error()),
+		slog.Any("suppressed", suppressed),
+	)
+}
@@ -0,0 +1,69 @@
+package causes
+
+import (
+	"net/url"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+// NewAuto is like New, but namespaces the kind with the package declaring
+// the error, in the format <package path>/<id>, so that the same id in two
+// packages does not collide.
+//
+// The id is a short identifier, unique within the package, e.g.
+// "cart_empty". Unlike the message, it is not shown to users, so the
+// message can be reworded without changing the kind.
+func NewAuto(code codes.Code, id, msg string) *errorDetail {
+	return &errorDetail{
+		code: code,
+		kind: callerPackage(2) + "/" + id,
+		msg:  msg,
+	}
+}
+
+// callerPackage returns the package path of the caller.
+func callerPackage(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+
+	return packagePath(runtime.FuncForPC(pc).Name())
+}
+
+// initFunc matches the package initializers, where NewAuto is usually
+// called when declaring package variables.
+var initFunc = regexp.MustCompile(`\.(init|glob\.)(\.func\d+(\.\d+)*)?$`)
+
+// packagePath returns the package path of a function name, in the format
+// github.com/user/repo/pkg.(*Type).Method.
+//
+// Dots in the last element of the package path are escaped as %2e by the
+// compiler, e.g. gopkg.in/yaml%2ev3.init, so the package ends at the first
+// dot after the last slash. Package initializers are also stripped from the
+// right, for names where the dots are not escaped.
+func packagePath(name string) string {
+	// Skip the type arguments of generic functions, which may contain
+	// slashes.
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+
+	if loc := initFunc.FindStringIndex(name); loc != nil {
+		name = name[:loc[0]]
+	} else {
+		i := strings.LastIndex(name, "/")
+		if j := strings.Index(name[i+1:], "."); j >= 0 {
+			name = name[:i+1+j]
+		}
+	}
+
+	if pkg, err := url.PathUnescape(name); err == nil {
+		return pkg
+	}
+
+	return name
+}
@@ -0,0 +1,27 @@
+package causes
+
+import "testing"
+
+func TestPackagePath(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"main.main", "main"},
+		{"github.com/alextanhongpin/errors/causes_test.init", "github.com/alextanhongpin/errors/causes_test"},
+		{"gopkg.in/yaml%2ev3.init", "gopkg.in/yaml.v3"},
+		{"gopkg.in/yaml.v3.init", "gopkg.in/yaml.v3"},
+		{"example.com/x%2ev1.init.func1", "example.com/x.v1"},
+		{"example.com/x.v1.init.func1.2", "example.com/x.v1"},
+		{"example.com/x.v2.glob..func1", "example.com/x.v2"},
+		{"example.com/x%2ev2.(*T).M", "example.com/x.v2"},
+		{"example.com/pkg.F[...]", "example.com/pkg"},
+		{"example.com/pkg.F[example.com/other.T]", "example.com/pkg"},
+	}
+
+	for _, tt := range tests {
+		if got := packagePath(tt.name); got != tt.want {
+			t.Errorf("packagePath(%q): want %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
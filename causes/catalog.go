@@ -0,0 +1,83 @@
+package causes
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var catalog struct {
+	sync.RWMutex
+	entries map[string]Entry
+}
+
+// Entry describes a cause recorded by Define.
+type Entry struct {
+	Kind    string `json:"kind"`
+	Code    string `json:"code"`
+	HTTP    int    `json:"http"`
+	Message string `json:"message"`
+}
+
+// Define is like New, but records the cause in the catalog, for generating
+// API documentation and client SDKs.
+//
+// Define is meant to be called when initializing package variables. It
+// panics if the kind is already defined.
+func Define(code codes.Code, kind, msg string, args ...any) *errorDetail {
+	err := New(code, kind, msg, args...)
+
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if e, ok := catalog.entries[kind]; ok {
+		panic(fmt.Sprintf("causes: kind %q is already defined with code %s", kind, e.Code))
+	}
+	if catalog.entries == nil {
+		catalog.entries = make(map[string]Entry)
+	}
+
+	catalog.entries[kind] = Entry{
+		Kind:    kind,
+		Code:    code.String(),
+		HTTP:    codes.HTTP(code),
+		Message: err.msg,
+	}
+
+	return err
+}
+
+// Catalog returns the causes recorded by Define, sorted by kind. The result
+// can be marshalled as JSON.
+func Catalog() []Entry {
+	catalog.RLock()
+	entries := make([]Entry, 0, len(catalog.entries))
+	for _, e := range catalog.entries {
+		entries = append(entries, e)
+	}
+	catalog.RUnlock()
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Compare(a.Kind, b.Kind)
+	})
+
+	return entries
+}
+
+// WriteCatalog writes the catalog as a Markdown table.
+func WriteCatalog(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("| Kind | Code | HTTP | Message |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+	for _, e := range Catalog() {
+		fmt.Fprintf(&sb, "| `%s` | %s | %d | %s |\n", e.Kind, e.Code, e.HTTP, strings.ReplaceAll(e.Message, "|", `\|`))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
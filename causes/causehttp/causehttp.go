@@ -0,0 +1,60 @@
+// package causehttp decodes HTTP error responses into causes errors.
+package causehttp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+// maxBodySize limits the error body that is read.
+const maxBodySize = 1 << 20
+
+// Problem is the RFC 7807 problem details body. It is stored as the data of
+// the decoded error.
+type Problem struct {
+	Type     string `json:"type,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Status   int    `json:"status,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// Decode returns nil for successful responses. Otherwise it returns an error
+// with the code mapped from the status code. If the body is an RFC 7807
+// problem, the type is used as the kind, and the detail or title as the
+// message.
+//
+// The caller is responsible for closing the response body.
+func Decode(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	code := codes.FromHTTP(resp.StatusCode)
+
+	var p Problem
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err == nil {
+		// Not all errors have a JSON body, fallback to the status.
+		_ = json.Unmarshal(b, &p)
+	}
+
+	kind := p.Type
+	if kind == "" || kind == "about:blank" {
+		kind = code.String()
+	}
+
+	msg := p.Detail
+	if msg == "" {
+		msg = p.Title
+	}
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+
+	return causes.NewHint[Problem](code, kind, "%s", msg).Wrap(p)
+}
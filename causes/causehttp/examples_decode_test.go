@@ -0,0 +1,58 @@
+package causehttp_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/causes/causehttp"
+)
+
+func ExampleDecode() {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "application/problem+json")
+	rec.WriteHeader(http.StatusConflict)
+	rec.WriteString(`{
+		"type": "https://example.com/probs/out-of-credit",
+		"title": "You do not have enough credit.",
+		"status": 409,
+		"detail": "Your current balance is 30, but that costs 50.",
+		"instance": "/account/12345/msgs/abc"
+	}`)
+
+	err := causehttp.Decode(rec.Result())
+	fmt.Println(err)
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(causes.HasKind(err, "https://example.com/probs/out-of-credit"))
+
+	p, ok := causes.DataOf[causehttp.Problem](err)
+	fmt.Println(p.Instance, ok)
+
+	// Without body.
+	resp := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Body:       http.NoBody,
+	}
+	err = causehttp.Decode(resp)
+	fmt.Println(err)
+	fmt.Println(causes.CodeOf(err))
+
+	// Successful responses.
+	resp = &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}
+	fmt.Println(causehttp.Decode(resp))
+
+	// Output:
+	// Your current balance is 30, but that costs 50.
+	// conflict
+	// true
+	// /account/12345/msgs/abc true
+	// Service Unavailable
+	// unavailable
+	// <nil>
+}
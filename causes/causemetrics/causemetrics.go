@@ -0,0 +1,55 @@
+// package causemetrics counts errors reported by the causes.OnError hook.
+package causemetrics
+
+import (
+	"expvar"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+// Collector counts errors by code and kind. It implements expvar.Var, so
+// the counts can be published with expvar.Publish.
+type Collector struct {
+	codes expvar.Map
+	kinds expvar.Map
+}
+
+// Publish returns a new Collector that is published to expvar with the
+// given name, and registered with causes.OnError.
+func Publish(name string) *Collector {
+	c := new(Collector)
+	expvar.Publish(name, c)
+	causes.OnError(c.Observe)
+
+	return c
+}
+
+// Observe counts the error.
+func (c *Collector) Observe(d causes.Detail) {
+	c.codes.Add(d.Code().String(), 1)
+	c.kinds.Add(d.Kind(), 1)
+}
+
+// Code returns the count of errors with the code.
+func (c *Collector) Code(code string) int64 {
+	return value(c.codes.Get(code))
+}
+
+// Kind returns the count of errors with the kind.
+func (c *Collector) Kind(kind string) int64 {
+	return value(c.kinds.Get(kind))
+}
+
+// String returns the counts as JSON.
+func (c *Collector) String() string {
+	return fmt.Sprintf(`{"codes": %s, "kinds": %s}`, c.codes.String(), c.kinds.String())
+}
+
+func value(v expvar.Var) int64 {
+	if i, ok := v.(*expvar.Int); ok {
+		return i.Value()
+	}
+
+	return 0
+}
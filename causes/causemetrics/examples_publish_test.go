@@ -0,0 +1,47 @@
+package causemetrics_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/causes/causemetrics"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var ErrUserNotFound = causes.New(codes.NotFound, "user/not_found", "User not found")
+
+type UserDetail struct {
+	UserID string
+}
+
+var ErrUserBanned = causes.NewHint[UserDetail](codes.Forbidden, "user/banned", "User is banned")
+
+func ExamplePublish() {
+	c := causemetrics.Publish("errors")
+
+	// Sentinels are not counted until they are wrapped.
+	_ = ErrUserNotFound.Wrap(sql.ErrNoRows)
+	_ = ErrUserNotFound.Wrap(sql.ErrNoRows)
+	_ = causes.Errorf("timeout")
+
+	// Wrapping a counted error does not count it again.
+	_ = ErrUserBanned.Wrap(UserDetail{UserID: "42"}).Wrap(sql.ErrNoRows)
+
+	// Later wraps of the same error are counted.
+	banned := ErrUserBanned.Wrap(UserDetail{UserID: "43"})
+	_ = banned.Wrap(sql.ErrNoRows)
+	_ = banned.Wrap(sql.ErrNoRows)
+
+	// Fan-out failures built with WithNamedCause are counted.
+	_ = ErrUserNotFound.WithNamedCause("db", sql.ErrNoRows)
+
+	fmt.Println(c.Code("not_found"))
+	fmt.Println(c.Kind("user/not_found"))
+	fmt.Println(c)
+
+	// Output:
+	// 3
+	// 3
+	// {"codes": {"forbidden": 3, "not_found": 3, "unknown": 1}, "kinds": {"unknown": 1, "user/banned": 3, "user/not_found": 3}}
+}
@@ -5,6 +5,10 @@ package causes
 import (
 	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync/atomic"
 
 	"github.com/alextanhongpin/errors/codes"
 )
@@ -26,6 +30,40 @@ func New(code codes.Code, kind, msg string, args ...any) *errorDetail {
 	}
 }
 
+// Std returns a new errorDetail with the Unknown code, as a drop-in
+// replacement for errors.New. Like errors.New, each call returns a distinct
+// error, which only matches itself and its copies made with Wrap.
+func Std(msg string) *errorDetail {
+	c := New(codes.Unknown, codes.Unknown.String(), "%s", msg)
+	c.origin = c
+
+	return c
+}
+
+// Errorf returns a new errorDetail with the Unknown code, as a drop-in
+// replacement for fmt.Errorf. The errors wrapped with %w are preserved, and
+// like Std, each call returns a distinct error.
+func Errorf(format string, args ...any) *errorDetail {
+	err := fmt.Errorf(format, args...)
+
+	c := &errorDetail{
+		code: codes.Unknown,
+		kind: codes.Unknown.String(),
+		msg:  err.Error(),
+	}
+	c.origin = c
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		c.err = u.Unwrap()
+	case interface{ Unwrap() []error }:
+		// Multiple %w, keep the error to unwrap all of them.
+		c.err = err
+	}
+
+	return notify(c)
+}
+
 // NewHint returns a partial error that needs to be fulfilled with the hinted
 // type.
 func NewHint[T any](code codes.Code, kind, msg string, args ...any) hint[T] {
@@ -53,9 +91,32 @@ type errorDetail struct {
 	msg  string
 	data any
 	err  error
+
+	// branches are the named sub-failures, rendered as a tree.
+	branches []branch
+
+	// origin identifies errors created by Std and Errorf, which share the
+	// same code and kind. It is kept by copies.
+	origin *errorDetail
+
+	// pending is set when the error is reported to the hooks, and cleared by
+	// its first re-wrap, which is then not reported again.
+	pending *atomic.Bool
+
+	// recovered is only set by Recover, to repanic with the original value.
+	recovered bool
+}
+
+type branch struct {
+	label string
+	err   error
 }
 
 func (c *errorDetail) Code() codes.Code {
+	if c == nil {
+		return 0
+	}
+
 	return c.code
 }
 
@@ -68,48 +129,252 @@ func (c *errorDetail) Code() codes.Code {
 //
 // Kind must be unique.
 func (c *errorDetail) Kind() string {
+	if c == nil {
+		return ""
+	}
+
 	return c.kind
 }
 
 func (c *errorDetail) Error() string {
-	return c.msg
+	if c == nil {
+		return ""
+	}
+
+	if len(c.branches) == 0 {
+		return c.msg
+	}
+
+	var sb strings.Builder
+	sb.WriteString(c.msg)
+	c.writeBranches(&sb, "")
+
+	return sb.String()
+}
+
+func (c *errorDetail) writeBranches(sb *strings.Builder, indent string) {
+	for i, b := range c.branches {
+		edge, next := "├── ", "│   "
+		if i == len(c.branches)-1 {
+			edge, next = "└── ", "    "
+		}
+
+		sb.WriteString("\n" + indent + edge + b.label + ": ")
+		if d, ok := b.err.(*errorDetail); ok && d != nil {
+			sb.WriteString(d.msg)
+			d.writeBranches(sb, indent+next)
+			continue
+		}
+
+		sb.WriteString(strings.ReplaceAll(b.err.Error(), "\n", "\n"+indent+next))
+	}
+}
+
+// WithNamedCause returns a copy of the cause with a labeled sub-failure, e.g.
+// one for each backend of a fan-out operation. The sub-failures are rendered
+// as a tree by Error, and are matched by errors.Is.
+func (c *errorDetail) WithNamedCause(label string, err error) *errorDetail {
+	if c == nil || err == nil {
+		return c
+	}
+
+	cp := *c
+	cp.branches = append(slices.Clip(c.branches), branch{
+		label: label,
+		err:   err,
+	})
+
+	return c.rewrap(&cp)
+}
+
+// NamedCause is a labeled sub-failure added with WithNamedCause.
+type NamedCause struct {
+	Label string
+	Err   error
+}
+
+// NamedCauses returns the sub-failures added with WithNamedCause.
+func (c *errorDetail) NamedCauses() []NamedCause {
+	if c == nil || len(c.branches) == 0 {
+		return nil
+	}
+
+	res := make([]NamedCause, len(c.branches))
+	for i, b := range c.branches {
+		res[i] = NamedCause{Label: b.label, Err: b.err}
+	}
+
+	return res
 }
 
 func (c *errorDetail) Message() string {
+	if c == nil {
+		return ""
+	}
+
 	return c.msg
 }
 
 func (c *errorDetail) Data() any {
+	if c == nil {
+		return nil
+	}
+
 	return c.data
 }
 
+// Wrap returns a copy of the cause that wraps err. Wrapping with a nil cause
+// returns err as it is.
 func (c *errorDetail) Wrap(err error) error {
+	if c == nil {
+		return err
+	}
+
 	cp := *c
 	cp.err = err
-	return &cp
+	return c.rewrap(&cp)
+}
+
+// Wrapf is like Wrap, but replaces the message with a more specific one.
+// The code and kind are kept, so the result still matches the cause.
+func (c *errorDetail) Wrapf(err error, msg string, args ...any) error {
+	if c == nil {
+		return err
+	}
+
+	cp := *c
+	cp.msg = fmt.Sprintf(msg, args...)
+	cp.err = err
+	return c.rewrap(&cp)
+}
+
+// rewrap reports the copy cp of c to the hooks, unless c was just reported
+// and this is its first re-wrap, e.g. Hint.Wrap(data).Wrap(err), which is
+// the same error.
+func (c *errorDetail) rewrap(cp *errorDetail) *errorDetail {
+	if c.pending != nil && c.pending.CompareAndSwap(true, false) {
+		return cp
+	}
+
+	return notify(cp)
 }
 
 func (c *errorDetail) Unwrap() error {
+	if c == nil {
+		return nil
+	}
+
 	return c.err
 }
 
 func (c *errorDetail) String() string {
+	if c == nil {
+		return "<nil>"
+	}
+
 	return fmt.Sprintf("%s/%s: %s", c.code, c.kind, c.msg)
 }
 
+// Format implements fmt.Formatter. The %+v verb prints the code, kind, data
+// and the cause chain, similar to github.com/pkg/errors.
+func (c *errorDetail) Format(f fmt.State, verb rune) {
+	if c == nil {
+		io.WriteString(f, "<nil>")
+		return
+	}
+
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			io.WriteString(f, c.verbose())
+			return
+		}
+
+		fallthrough
+	case 's':
+		io.WriteString(f, c.Error())
+	case 'q':
+		fmt.Fprintf(f, "%q", c.Error())
+	}
+}
+
+func (c *errorDetail) verbose() string {
+	var sb strings.Builder
+	sb.WriteString(c.String())
+	if c.data != nil {
+		fmt.Fprintf(&sb, "\n    data: %+v", c.data)
+	}
+	c.writeBranches(&sb, "")
+	if c.err != nil {
+		fmt.Fprintf(&sb, "\nCaused by: %+v", c.err)
+	}
+
+	return sb.String()
+}
+
 func (c *errorDetail) Is(err error) bool {
+	if c == nil {
+		return false
+	}
+
+	if m, ok := err.(*matcher); ok && m.match(c) {
+		return true
+	}
+
 	if errors.Is(c.err, err) {
 		return true
 	}
 
+	for _, b := range c.branches {
+		if errors.Is(b.err, err) {
+			return true
+		}
+	}
+
 	var cause *errorDetail
-	ok := errors.As(err, &cause)
+	if !errors.As(err, &cause) {
+		return false
+	}
+
+	if c.origin != nil || cause.origin != nil {
+		return c.origin == cause.origin
+	}
 
-	return ok &&
-		c.code == cause.code &&
+	return c.code == cause.code &&
 		c.kind == cause.kind
 }
 
+// ByCode returns a target for errors.Is that matches any cause with the
+// code, for when the sentinel error is not accessible.
+func ByCode(code codes.Code) error {
+	return &matcher{
+		desc: fmt.Sprintf("code %s", code),
+		match: func(c *errorDetail) bool {
+			return c.code == code
+		},
+	}
+}
+
+// ByKind returns a target for errors.Is that matches any cause with the
+// kind, for when the sentinel error is not accessible.
+func ByKind(kind string) error {
+	return &matcher{
+		desc: fmt.Sprintf("kind %s", kind),
+		match: func(c *errorDetail) bool {
+			return c.kind == kind
+		},
+	}
+}
+
+type matcher struct {
+	desc  string
+	match func(*errorDetail) bool
+}
+
+func (m *matcher) Error() string {
+	return m.desc
+}
+
 type errorHint[T any] struct {
 	err *errorDetail
 }
@@ -119,6 +384,10 @@ func (e *errorHint[T]) Is(err error) bool {
 }
 
 func (e *errorHint[T]) Wrap(t T) *errorDetail {
+	return notify(e.wrap(t))
+}
+
+func (e *errorHint[T]) wrap(t T) *errorDetail {
 	cp := *e.err
 	cp.data = t
 	return &cp
@@ -132,3 +401,75 @@ func (e *errorHint[T]) Unwrap(err error) (v T, ok bool) {
 
 	return
 }
+
+// IsCode returns true if any cause in the error chain has the code.
+func IsCode(err error, code codes.Code) bool {
+	return walk(err, func(d *errorDetail) bool {
+		return d.code == code
+	})
+}
+
+// HasKind returns true if any cause in the error chain has the kind.
+func HasKind(err error, kind string) bool {
+	return walk(err, func(d *errorDetail) bool {
+		return d.kind == kind
+	})
+}
+
+// CodeOf returns the code of the outermost cause in the error chain, or
+// Unknown if there is none.
+func CodeOf(err error) codes.Code {
+	code := codes.Unknown
+	walk(err, func(d *errorDetail) bool {
+		code = d.code
+		return true
+	})
+
+	return code
+}
+
+// DataOf returns the first data of type T found in the error chain. Unlike
+// errors.As, it continues past causes whose data is not of type T.
+func DataOf[T any](err error) (t T, ok bool) {
+	walk(err, func(d *errorDetail) bool {
+		t, ok = d.data.(T)
+		return ok
+	})
+
+	return
+}
+
+// walk calls fn for every errorDetail in the error chain, stopping when fn
+// returns true.
+func walk(err error, fn func(*errorDetail) bool) bool {
+	for err != nil {
+		if d, ok := err.(*errorDetail); ok && d != nil {
+			if fn(d) {
+				return true
+			}
+
+			for _, b := range d.branches {
+				if walk(b.err, fn) {
+					return true
+				}
+			}
+		}
+
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			err = u.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, err := range u.Unwrap() {
+				if walk(err, fn) {
+					return true
+				}
+			}
+
+			return false
+		default:
+			return false
+		}
+	}
+
+	return false
+}
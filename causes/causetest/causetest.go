@@ -0,0 +1,142 @@
+// package causetest provides test helpers for comparing causes errors.
+package causetest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+// AssertEqual fails the test if the causes in the error chains differ in
+// code, kind, message or data, or if the root errors differ in message.
+// Other wrappers, such as stacktraces, are ignored.
+func AssertEqual(t testing.TB, want, got error) {
+	t.Helper()
+
+	if diff := Diff(want, got); diff != "" {
+		t.Errorf("causetest: errors differ (-want +got):\n%s", diff)
+	}
+}
+
+// Diff returns a readable diff of the causes in the error chains, or an
+// empty string if they are equal.
+func Diff(want, got error) string {
+	a, b := flatten(want), flatten(got)
+
+	var sb strings.Builder
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y string
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x == y {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "#%d\n", i)
+		if x != "" {
+			fmt.Fprintf(&sb, "-\t%s\n", x)
+		}
+		if y != "" {
+			fmt.Fprintf(&sb, "+\t%s\n", y)
+		}
+	}
+
+	return sb.String()
+}
+
+// flatten returns the causes and root errors in the chain as strings.
+func flatten(err error) []string {
+	var res []string
+	for _, l := range chain(err) {
+		var s string
+		if d, ok := l.err.(causes.Detail); ok {
+			s = format(d)
+		} else {
+			s = fmt.Sprintf("%T: %s", l.err, l.err)
+		}
+		if l.label != "" {
+			s = l.label + ": " + s
+		}
+
+		res = append(res, s)
+	}
+
+	return res
+}
+
+// link is an error in the chain, with the labels of the named causes it
+// belongs to.
+type link struct {
+	label string
+	err   error
+}
+
+type namedCauser interface {
+	NamedCauses() []causes.NamedCause
+}
+
+// chain returns the causes and root errors in the chain, including the
+// named causes.
+func chain(err error) []link {
+	return chainLabel(err, "")
+}
+
+func chainLabel(err error, label string) []link {
+	if err == nil {
+		return nil
+	}
+
+	var next []error
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if err := u.Unwrap(); err != nil {
+			next = append(next, err)
+		}
+	case interface{ Unwrap() []error }:
+		next = u.Unwrap()
+	}
+
+	var res []link
+	if _, ok := err.(causes.Detail); ok || len(next) == 0 {
+		res = append(res, link{label: label, err: err})
+	}
+
+	if n, ok := err.(namedCauser); ok {
+		for _, c := range n.NamedCauses() {
+			name := c.Label
+			if label != "" {
+				name = label + "." + name
+			}
+
+			res = append(res, chainLabel(c.Err, name)...)
+		}
+	}
+
+	for _, err := range next {
+		res = append(res, chainLabel(err, label)...)
+	}
+
+	return res
+}
+
+func format(d causes.Detail) string {
+	s := fmt.Sprintf("%s/%s: %s", d.Code(), d.Kind(), d.Message())
+	if d.Data() == nil {
+		return s
+	}
+
+	// Pointers are compared by the value they point to.
+	v := reflect.Indirect(reflect.ValueOf(d.Data()))
+	if !v.IsValid() {
+		return s + " <nil>"
+	}
+
+	return fmt.Sprintf("%s %#v", s, v.Interface())
+}
@@ -0,0 +1,100 @@
+package causetest_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/causes/causetest"
+	"github.com/alextanhongpin/errors/codes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+type Balance struct {
+	Amount int
+}
+
+var ErrInsufficientBalance = causes.NewHint[Balance](codes.PreconditionFailed, "balance/insufficient", "Insufficient balance")
+
+func TestAssertEqual(t *testing.T) {
+	want := ErrInsufficientBalance.Wrap(Balance{Amount: 10}).Wrap(sql.ErrNoRows)
+
+	// Stacktraces and other wrappers are ignored.
+	got := stacktrace.Wrap(fmt.Errorf("pay: %w", ErrInsufficientBalance.Wrap(Balance{Amount: 10}).Wrap(sql.ErrNoRows)))
+
+	causetest.AssertEqual(t, want, got)
+}
+
+// recorder records the failures reported by the helpers.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+var ErrBalanceMissing = causes.NewHint[*Balance](codes.NotFound, "balance/missing", "Balance is missing")
+
+func TestAssertEqualFails(t *testing.T) {
+	r := &recorder{TB: t}
+
+	// Typed nil pointers are valid data.
+	causetest.AssertEqual(r, ErrBalanceMissing.Wrap(nil), ErrBalanceMissing.Wrap(&Balance{Amount: 1}))
+
+	want := []string{
+		"causetest: errors differ (-want +got):\n" +
+			"#0\n" +
+			"-\tnot_found/balance/missing: Balance is missing <nil>\n" +
+			"+\tnot_found/balance/missing: Balance is missing causetest_test.Balance{Amount:1}\n",
+	}
+	if len(r.errors) != len(want) || r.errors[0] != want[0] {
+		t.Errorf("want %q, got %q", want, r.errors)
+	}
+}
+
+func ExampleDiff() {
+	want := ErrInsufficientBalance.Wrap(Balance{Amount: 10})
+	got := ErrInsufficientBalance.Wrap(Balance{Amount: 20}).Wrap(sql.ErrNoRows)
+
+	fmt.Print(causetest.Diff(want, got))
+
+	// Output:
+	// #0
+	// -	precondition_failed/balance/insufficient: Insufficient balance causetest_test.Balance{Amount:10}
+	// +	precondition_failed/balance/insufficient: Insufficient balance causetest_test.Balance{Amount:20}
+	// #1
+	// +	*errors.errorString: sql: no rows in result set
+}
+
+func ExampleDiff_namedCause() {
+	want := ErrInsufficientBalance.Wrap(Balance{Amount: 10}).WithNamedCause("db", context.DeadlineExceeded)
+	got := ErrInsufficientBalance.Wrap(Balance{Amount: 10}).WithNamedCause("cache", io.EOF)
+
+	fmt.Print(causetest.Diff(want, got))
+
+	// Output:
+	// #1
+	// -	db: context.deadlineExceededError: context deadline exceeded
+	// +	cache: *errors.errorString: EOF
+}
+
+func TestGolden(t *testing.T) {
+	err := fmt.Errorf("pay: %w", ErrInsufficientBalance.Wrap(Balance{Amount: 10}).Wrap(sql.ErrNoRows))
+
+	causetest.Golden(t, err, "testdata/balance_insufficient.json")
+}
+
+func TestGoldenNamedCause(t *testing.T) {
+	err := ErrInsufficientBalance.Wrap(Balance{Amount: 10}).
+		WithNamedCause("db", context.DeadlineExceeded).
+		WithNamedCause("cache", io.EOF)
+
+	causetest.Golden(t, err, "testdata/balance_insufficient_named_cause.json")
+}
@@ -0,0 +1,84 @@
+package causetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+var update = flag.Bool("causetest.update", false, "update the golden files")
+
+type goldenError struct {
+	Label   string `json:"label,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// Golden compares the causes in the error chain with the golden file at
+// path, as indented JSON. Run the tests with -causetest.update to write the
+// golden file instead.
+func Golden(t testing.TB, err error, path string) {
+	t.Helper()
+
+	got, err := marshalGolden(err)
+	if err != nil {
+		t.Fatalf("causetest: marshal golden: %v", err)
+	}
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("causetest: create golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("causetest: write golden: %v", err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("causetest: read golden: %v", err)
+	}
+
+	if !bytes.Equal(want, got) {
+		t.Errorf("causetest: golden %s differs:\nwant:\n%s\ngot:\n%s", path, want, got)
+	}
+}
+
+func marshalGolden(err error) ([]byte, error) {
+	res := make([]goldenError, 0)
+	for _, l := range chain(err) {
+		if d, ok := l.err.(causes.Detail); ok {
+			res = append(res, goldenError{
+				Label:   l.label,
+				Code:    d.Code().String(),
+				Kind:    d.Kind(),
+				Message: d.Message(),
+				Data:    d.Data(),
+			})
+		} else {
+			res = append(res, goldenError{
+				Label:   l.label,
+				Type:    fmt.Sprintf("%T", l.err),
+				Message: l.err.Error(),
+			})
+		}
+	}
+
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
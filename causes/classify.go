@@ -0,0 +1,135 @@
+package causes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var (
+	// ErrConnRefused matches errors converted from syscall.ECONNREFUSED.
+	ErrConnRefused = New(codes.Unavailable, "net/connection_refused", "Connection refused")
+
+	// ErrConnReset matches errors converted from syscall.ECONNRESET and
+	// syscall.EPIPE.
+	ErrConnReset = New(codes.Unavailable, "net/connection_reset", "Connection reset")
+
+	// ErrHostNotFound matches errors converted from a *net.DNSError for
+	// a host that does not exist.
+	ErrHostNotFound = New(codes.NotFound, "net/host_not_found", "Host not found")
+
+	// ErrDNS matches errors converted from other *net.DNSError.
+	ErrDNS = New(codes.Unavailable, "net/dns", "DNS lookup failed")
+
+	// ErrTLS matches errors converted from TLS handshake and certificate
+	// errors.
+	ErrTLS = New(codes.Internal, "net/tls", "TLS handshake failed")
+
+	// ErrEOF matches errors converted from io.EOF and io.ErrUnexpectedEOF.
+	ErrEOF = New(codes.Unavailable, "io/eof", "Connection closed unexpectedly")
+
+	// ErrNetwork matches errors converted from other *net.OpError.
+	ErrNetwork = New(codes.Unavailable, "net/unavailable", "Network is unavailable")
+)
+
+// Classifier converts an error into a cause, and returns false if it does
+// not match.
+type Classifier func(err error) (error, bool)
+
+var classifiers struct {
+	sync.RWMutex
+	list []Classifier
+}
+
+// RegisterClassifier adds a classifier that runs before the built-in
+// classifiers, in the order they are registered.
+func RegisterClassifier(c Classifier) {
+	classifiers.Lock()
+	classifiers.list = append(classifiers.list, c)
+	classifiers.Unlock()
+}
+
+// Classify converts common stdlib errors, such as context, io and net
+// errors, into a cause. The original error is kept in the chain.
+// Errors that are already a cause, or that are not recognized, are returned
+// as is.
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var d *errorDetail
+	if errors.As(err, &d) {
+		return err
+	}
+
+	classifiers.RLock()
+	list := make([]Classifier, 0, len(classifiers.list)+2)
+	list = append(list, classifiers.list...)
+	classifiers.RUnlock()
+
+	for _, c := range append(list, classifyContext, classifyNet) {
+		if e, ok := c(err); ok {
+			return e
+		}
+	}
+
+	return err
+}
+
+// Retryable reports whether the error is classified with a code that is
+// transient, and may succeed when retried.
+func Retryable(err error) bool {
+	switch CodeOf(Classify(err)) {
+	case codes.Aborted,
+		codes.DeadlineExceeded,
+		codes.TooManyRequests,
+		codes.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+func classifyNet(err error) (error, bool) {
+	var (
+		dnsErr  *net.DNSError
+		hostErr x509.HostnameError
+		authErr x509.UnknownAuthorityError
+		certErr x509.CertificateInvalidError
+		recErr  tls.RecordHeaderError
+		tlsErr  *tls.CertificateVerificationError
+		opErr   *net.OpError
+	)
+
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrConnRefused.Wrap(err), true
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.EPIPE):
+		return ErrConnReset.Wrap(err), true
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsNotFound {
+			return ErrHostNotFound.Wrap(err), true
+		}
+
+		return ErrDNS.Wrap(err), true
+	case errors.As(err, &tlsErr),
+		errors.As(err, &hostErr),
+		errors.As(err, &authErr),
+		errors.As(err, &certErr),
+		errors.As(err, &recErr):
+		return ErrTLS.Wrap(err), true
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrEOF.Wrap(err), true
+	case errors.As(err, &opErr):
+		return ErrNetwork.Wrap(err), true
+	default:
+		return nil, false
+	}
+}
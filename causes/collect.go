@@ -0,0 +1,53 @@
+package causes
+
+import (
+	"context"
+	"sync"
+)
+
+type collectorKey struct{}
+
+type collector struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// WithCollector returns a context that collects the errors appended with
+// Append, so that soft failures from different layers can be reported
+// together without aborting the request.
+func WithCollector(ctx context.Context) context.Context {
+	return context.WithValue(ctx, collectorKey{}, new(collector))
+}
+
+// Append adds a non-nil error to the collector in the context. It returns
+// false if the context has no collector.
+func Append(ctx context.Context, err error) bool {
+	c, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return false
+	}
+
+	if err != nil {
+		c.mu.Lock()
+		c.errs = append(c.errs, err)
+		c.mu.Unlock()
+	}
+
+	return true
+}
+
+// Drain returns the collected errors in the order they were appended, and
+// clears the collector.
+func Drain(ctx context.Context) []error {
+	c, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return nil
+	}
+
+	c.mu.Lock()
+	errs := c.errs
+	c.errs = nil
+	c.mu.Unlock()
+
+	return errs
+}
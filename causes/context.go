@@ -0,0 +1,56 @@
+package causes
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var (
+	// ErrCanceled matches errors converted from context.Canceled.
+	ErrCanceled = New(codes.Canceled, "context/canceled", "Operation is canceled")
+
+	// ErrDeadlineExceeded matches errors converted from
+	// context.DeadlineExceeded.
+	ErrDeadlineExceeded = New(codes.DeadlineExceeded, "context/deadline_exceeded", "Operation exceeded the deadline")
+
+	// ErrTimeout matches errors converted from a net.Error timeout.
+	ErrTimeout = New(codes.Unavailable, "net/timeout", "Network operation timed out")
+)
+
+// FromContextErr classifies cancellation and timeout errors, such as
+// ctx.Err(), so that callers do not need to map them manually.
+// Errors that are already a cause, or that are not recognized, are returned
+// as is.
+func FromContextErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var d *errorDetail
+	if errors.As(err, &d) {
+		return err
+	}
+
+	if e, ok := classifyContext(err); ok {
+		return e
+	}
+
+	return err
+}
+
+func classifyContext(err error) (error, bool) {
+	var ne net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrDeadlineExceeded.Wrap(err), true
+	case errors.Is(err, context.Canceled):
+		return ErrCanceled.Wrap(err), true
+	case errors.As(err, &ne) && ne.Timeout():
+		return ErrTimeout.Wrap(err), true
+	default:
+		return nil, false
+	}
+}
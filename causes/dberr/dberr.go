@@ -0,0 +1,175 @@
+// package dberr translates database driver errors into causes errors.
+package dberr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+// Detail is the data of the translated errors.
+type Detail struct {
+	// Code is the SQLSTATE or the vendor error number.
+	Code string
+
+	// Constraint is the name of the violated constraint, if any.
+	Constraint string
+}
+
+var (
+	ErrNotFound         = causes.NewHint[Detail](codes.NotFound, "db/not_found", "Record not found")
+	ErrExists           = causes.NewHint[Detail](codes.Exists, "db/unique_violation", "Record already exists")
+	ErrForeignKey       = causes.NewHint[Detail](codes.PreconditionFailed, "db/foreign_key_violation", "Referenced record does not exist")
+	ErrConstraint       = causes.NewHint[Detail](codes.BadRequest, "db/constraint_violation", "Record violates a constraint")
+	ErrConflict         = causes.NewHint[Detail](codes.Aborted, "db/conflict", "Transaction conflicts with another transaction")
+	ErrCanceled         = causes.NewHint[Detail](codes.Canceled, "db/canceled", "Query is canceled")
+	ErrDeadlineExceeded = causes.NewHint[Detail](codes.DeadlineExceeded, "db/deadline_exceeded", "Query exceeded the deadline")
+	ErrUnavailable      = causes.NewHint[Detail](codes.Unavailable, "db/unavailable", "Database is unavailable")
+)
+
+// Matcher translates a driver error, and returns false if it does not
+// match.
+type Matcher func(err error) (error, bool)
+
+var registry struct {
+	sync.RWMutex
+	matchers []Matcher
+}
+
+// Register adds a matcher that runs before the built-in matchers, in the
+// order they are registered.
+func Register(m Matcher) {
+	registry.Lock()
+	registry.matchers = append(registry.matchers, m)
+	registry.Unlock()
+}
+
+// Translate returns the error wrapped with the matching cause, or the error
+// as it is if no matcher applies. The original error is kept in the chain.
+// Errors that already contain a cause are returned as it is, so Translate
+// can be called at every layer.
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var d causes.Detail
+	if errors.As(err, &d) {
+		return err
+	}
+
+	registry.RLock()
+	matchers := make([]Matcher, 0, len(registry.matchers)+2)
+	matchers = append(matchers, registry.matchers...)
+	registry.RUnlock()
+
+	for _, m := range append(matchers, matchSentinel, matchSQLState) {
+		if e, ok := m(err); ok {
+			return e
+		}
+	}
+
+	return err
+}
+
+func matchSentinel(err error) (error, bool) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrNotFound.Wrap(Detail{}).Wrap(err), true
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrDeadlineExceeded.Wrap(Detail{}).Wrap(err), true
+	case errors.Is(err, context.Canceled):
+		return ErrCanceled.Wrap(Detail{}).Wrap(err), true
+	case errors.Is(err, sql.ErrConnDone), errors.Is(err, driver.ErrBadConn):
+		return ErrUnavailable.Wrap(Detail{}).Wrap(err), true
+	default:
+		return nil, false
+	}
+}
+
+// matchSQLState matches PostgreSQL errors from drivers that implement
+// SQLState, such as pgx and pq.
+func matchSQLState(err error) (error, bool) {
+	var e interface{ SQLState() string }
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	d := Detail{
+		Code:       e.SQLState(),
+		Constraint: constraint(e),
+	}
+
+	// https://www.postgresql.org/docs/current/errcodes-appendix.html
+	switch d.Code {
+	case "23505": // unique_violation
+		return ErrExists.Wrap(d).Wrap(err), true
+	case "23503": // foreign_key_violation
+		return ErrForeignKey.Wrap(d).Wrap(err), true
+	case "23502", "23514", "23P01": // not_null_violation, check_violation, exclusion_violation
+		return ErrConstraint.Wrap(d).Wrap(err), true
+	case "40001", "40P01": // serialization_failure, deadlock_detected
+		return ErrConflict.Wrap(d).Wrap(err), true
+	case "57014": // query_canceled
+		return ErrCanceled.Wrap(d).Wrap(err), true
+	case "08000", "08003", "08006", "57P01": // connection_exception, connection_does_not_exist, connection_failure, admin_shutdown
+		return ErrUnavailable.Wrap(d).Wrap(err), true
+	default:
+		return nil, false
+	}
+}
+
+// MySQL returns a matcher for MySQL errors, given a function that extracts
+// the error number, e.g. from *mysql.MySQLError.
+func MySQL(number func(error) (uint16, bool)) Matcher {
+	return func(err error) (error, bool) {
+		n, ok := number(err)
+		if !ok {
+			return nil, false
+		}
+
+		d := Detail{
+			Code: strconv.Itoa(int(n)),
+		}
+
+		// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+		switch n {
+		case 1062: // ER_DUP_ENTRY
+			return ErrExists.Wrap(d).Wrap(err), true
+		case 1451, 1452: // ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2
+			return ErrForeignKey.Wrap(d).Wrap(err), true
+		case 1048, 3819: // ER_BAD_NULL_ERROR, ER_CHECK_CONSTRAINT_VIOLATED
+			return ErrConstraint.Wrap(d).Wrap(err), true
+		case 1205, 1213: // ER_LOCK_WAIT_TIMEOUT, ER_LOCK_DEADLOCK
+			return ErrConflict.Wrap(d).Wrap(err), true
+		case 3024: // ER_QUERY_TIMEOUT
+			return ErrDeadlineExceeded.Wrap(d).Wrap(err), true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// constraint returns the constraint name from the ConstraintName (pgx) or
+// Constraint (pq) field, since the drivers do not expose it by method.
+func constraint(e any) string {
+	v := reflect.Indirect(reflect.ValueOf(e))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for _, name := range []string{"ConstraintName", "Constraint"} {
+		if f := v.FieldByName(name); f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,91 @@
+package dberr_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/causes/dberr"
+)
+
+// PgError mimics *pgconn.PgError.
+type PgError struct {
+	Code           string
+	Message        string
+	ConstraintName string
+}
+
+func (e *PgError) Error() string {
+	return e.Message
+}
+
+func (e *PgError) SQLState() string {
+	return e.Code
+}
+
+func ExampleTranslate() {
+	err := dberr.Translate(fmt.Errorf("find user: %w", sql.ErrNoRows))
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(errors.Is(err, sql.ErrNoRows))
+
+	// Translated errors are returned as it is.
+	fmt.Println(dberr.Translate(err) == err)
+
+	err = dberr.Translate(&PgError{
+		Code:           "23505",
+		Message:        `duplicate key value violates unique constraint "users_email_key"`,
+		ConstraintName: "users_email_key",
+	})
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(dberr.ErrExists.Is(err))
+
+	d, ok := dberr.ErrExists.Unwrap(err)
+	fmt.Printf("%+v\n", d)
+	fmt.Println(ok)
+
+	// Unknown errors are returned as it is.
+	fmt.Println(dberr.Translate(errors.New("syntax error")))
+
+	// Output:
+	// not_found
+	// true
+	// true
+	// exists
+	// true
+	// {Code:23505 Constraint:users_email_key}
+	// true
+	// syntax error
+}
+
+// MySQLError mimics *mysql.MySQLError.
+type MySQLError struct {
+	Number  uint16
+	Message string
+}
+
+func (e *MySQLError) Error() string {
+	return e.Message
+}
+
+func ExampleMySQL() {
+	dberr.Register(dberr.MySQL(func(err error) (uint16, bool) {
+		var e *MySQLError
+		if errors.As(err, &e) {
+			return e.Number, true
+		}
+
+		return 0, false
+	}))
+
+	err := dberr.Translate(&MySQLError{
+		Number:  1062,
+		Message: "Duplicate entry 'john@mail.com' for key 'email'",
+	})
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(err)
+
+	// Output:
+	// exists
+	// Record already exists
+}
@@ -0,0 +1,129 @@
+package causes
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+// Canonical returns a stable representation of the error chain for
+// deduplication. Causes are identified by their code and kind, so data and
+// interpolated messages do not affect the result. Wrappers such as
+// stacktraces are skipped, and only the root error's message is kept.
+// Named causes are included, prefixed with their label.
+func Canonical(err error) []byte {
+	if err == nil {
+		return nil
+	}
+
+	var res []string
+	canonical(err, "", &res)
+
+	return []byte(strings.Join(res, "\n"))
+}
+
+func canonical(err error, label string, res *[]string) {
+	var next []error
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if err := u.Unwrap(); err != nil {
+			next = append(next, err)
+		}
+	case interface{ Unwrap() []error }:
+		next = u.Unwrap()
+	}
+
+	prefix := label
+	if prefix != "" {
+		prefix += ": "
+	}
+
+	c, ok := err.(*errorDetail)
+	switch {
+	case ok && c.kind == codes.Unknown.String():
+		// Errorf and Std are only identified by their message.
+		*res = append(*res, prefix+c.String())
+	case ok:
+		*res = append(*res, fmt.Sprintf("%s%s/%s", prefix, c.code, c.kind))
+	case len(next) == 0:
+		*res = append(*res, fmt.Sprintf("%s%T: %s", prefix, err, err))
+	}
+
+	if ok {
+		for _, b := range c.branches {
+			canonical(b.err, join(label, b.label), res)
+		}
+	}
+
+	for _, err := range next {
+		canonical(err, label, res)
+	}
+}
+
+// join joins the labels of nested named causes, like slog groups.
+func join(label, name string) string {
+	if label == "" {
+		return name
+	}
+
+	return label + "." + name
+}
+
+// Dedup reports whether an error has been seen within a time window, based
+// on its Canonical representation. It is safe for concurrent use.
+type Dedup struct {
+	mu     sync.Mutex
+	window time.Duration
+	seen   map[string]time.Time
+	swept  time.Time
+}
+
+// NewDedup returns a Dedup that remembers errors for the given window.
+func NewDedup(window time.Duration) *Dedup {
+	return &Dedup{
+		window: window,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// Seen returns true if the same error was seen within the window. Otherwise
+// the error is recorded and false is returned.
+func (d *Dedup) Seen(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	key := string(Canonical(err))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	d.sweep(now)
+
+	if t, ok := d.seen[key]; ok && now.Sub(t) < d.window {
+		return true
+	}
+
+	d.seen[key] = now
+
+	return false
+}
+
+// sweep removes expired entries at most once per window.
+func (d *Dedup) sweep(now time.Time) {
+	if now.Sub(d.swept) < d.window {
+		return
+	}
+
+	for k, t := range d.seen {
+		if now.Sub(t) >= d.window {
+			delete(d.seen, k)
+		}
+	}
+
+	d.swept = now
+}
@@ -0,0 +1,44 @@
+package causes_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+var errRollback = errors.New("rollback: connection closed")
+
+func ExampleAttach() {
+	err := causes.Attach(ErrCheckoutFailed, errRollback)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrCheckoutFailed))
+	fmt.Println(errors.Is(err, errRollback))
+	fmt.Println(causes.Suppressed(err))
+	fmt.Printf("%+v\n", err)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+	logger.Error("failed to checkout", causes.Attr(err))
+	fmt.Println(strings.TrimSpace(buf.String()))
+
+	// Output:
+	// Checkout failed
+	// true
+	// false
+	// [rollback: connection closed]
+	// conflict/checkout/failed: Checkout failed
+	// Suppressed: rollback: connection closed
+	// level=ERROR msg="failed to checkout" error.code=conflict error.kind=checkout/failed error.message="Checkout failed" error.suppressed="[rollback: connection closed]"
+}
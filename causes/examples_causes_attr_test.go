@@ -0,0 +1,35 @@
+package causes_test
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+func ExampleAttr() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+
+	err := ErrPayoutDeclined.Wrap(PayoutDeclinedErrorDetail{
+		PayoutID: "PO-42",
+		Reason:   "Insufficient balance in account",
+	}).Wrap(sql.ErrNoRows)
+	logger.Error("failed to pay", causes.Attr(err))
+
+	err = fmt.Errorf("payout: %w", err)
+	logger.Error("failed to pay", causes.Args(err)...)
+
+	// Output:
+	// {"level":"ERROR","msg":"failed to pay","error":{"code":"conflict","kind":"payout/declined","message":"Payout is declined","data":{"PayoutID":"PO-42","Reason":"Insufficient balance in account"},"cause":"sql: no rows in result set"}}
+	// {"level":"ERROR","msg":"failed to pay","error":{"message":"payout: Payout is declined","cause":{"code":"conflict","kind":"payout/declined","message":"Payout is declined","data":{"PayoutID":"PO-42","Reason":"Insufficient balance in account"},"cause":"sql: no rows in result set"}}}
+}
@@ -0,0 +1,30 @@
+package causes_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+func ExampleByKind() {
+	err := fmt.Errorf("get document: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))
+	fmt.Println(errors.Is(err, causes.ByKind("document/not_found")))
+	fmt.Println(errors.Is(err, causes.ByKind("user/not_found")))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleByCode() {
+	err := fmt.Errorf("get document: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))
+	fmt.Println(errors.Is(err, causes.ByCode(codes.NotFound)))
+	fmt.Println(errors.Is(err, causes.ByCode(codes.Conflict)))
+
+	// Output:
+	// true
+	// false
+}
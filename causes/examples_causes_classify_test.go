@@ -0,0 +1,44 @@
+package causes_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var ErrQuotaExceeded = causes.New(codes.TooManyRequests, "quota/exceeded", "Quota exceeded")
+
+var errQuota = errors.New("quota exceeded")
+
+func ExampleClassify() {
+	causes.RegisterClassifier(func(err error) (error, bool) {
+		if errors.Is(err, errQuota) {
+			return ErrQuotaExceeded.Wrap(err), true
+		}
+
+		return nil, false
+	})
+
+	for _, err := range []error{
+		&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		&net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true},
+		fmt.Errorf("read body: %w", io.ErrUnexpectedEOF),
+		fmt.Errorf("call api: %w", errQuota),
+		errors.New("bad"),
+	} {
+		err = causes.Classify(err)
+		fmt.Println(causes.CodeOf(err), causes.Retryable(err), err)
+	}
+
+	// Output:
+	// unavailable true Connection refused
+	// not_found false Host not found
+	// unavailable true Connection closed unexpectedly
+	// too_many_requests true Quota exceeded
+	// unknown false bad
+}
@@ -0,0 +1,42 @@
+package causes_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var ErrAvatarUnavailable = causes.New(codes.Unavailable, "avatar/unavailable", "Avatar is unavailable")
+
+func enrichAvatar(ctx context.Context) {
+	// Optional lookup, the request continues without the avatar.
+	causes.Append(ctx, ErrAvatarUnavailable.Wrap(sql.ErrConnDone))
+}
+
+func ExampleWithCollector() {
+	ctx := causes.WithCollector(context.Background())
+	enrichAvatar(ctx)
+	causes.Append(ctx, nil)
+
+	errs := causes.Drain(ctx)
+	fmt.Println(len(errs))
+	fmt.Println(errors.Join(errs...))
+	fmt.Println(causes.IsCode(errs[0], codes.Unavailable))
+
+	// Drained errors are removed.
+	fmt.Println(len(causes.Drain(ctx)))
+
+	// Without collector.
+	fmt.Println(causes.Append(context.Background(), ErrAvatarUnavailable))
+
+	// Output:
+	// 1
+	// Avatar is unavailable
+	// true
+	// 0
+	// false
+}
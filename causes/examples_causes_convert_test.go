@@ -10,7 +10,7 @@ import (
 
 var ErrProductNotFound = causes.New(codes.NotFound, "product/not_found", "The product is not found")
 
-func ExampleCausesConvert() {
+func Example_convert() {
 	var err error = ErrProductNotFound
 	fmt.Println(errors.Is(err, ErrProductNotFound))
 
@@ -0,0 +1,34 @@
+package causes_test
+
+import (
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+type InvoiceDetail struct {
+	InvoiceID string
+}
+
+var ErrInvoiceVoid = causes.NewHint[InvoiceDetail](codes.PreconditionFailed, "invoice/void", "Invoice is void")
+
+var ErrCheckoutFailed = causes.New(codes.Conflict, "checkout/failed", "Checkout failed")
+
+func ExampleDataOf() {
+	var err error = ErrInvoiceVoid.Wrap(InvoiceDetail{InvoiceID: "INV-42"})
+	err = fmt.Errorf("pay: %w", ErrCheckoutFailed.Wrap(err))
+
+	// The outer cause has no data, so the inner one is returned.
+	d, ok := causes.DataOf[InvoiceDetail](err)
+	fmt.Printf("%+v\n", d)
+	fmt.Println(ok)
+
+	_, ok = causes.DataOf[string](err)
+	fmt.Println(ok)
+
+	// Output:
+	// {InvoiceID:INV-42}
+	// true
+	// false
+}
@@ -0,0 +1,56 @@
+package causes_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleCanonical() {
+	err := ErrOrderLimitExceeded.Wrap(OrderLimitExceededDetail{
+		OrderID: "ORD-42",
+		Limit:   10,
+	}).Wrap(stacktrace.Wrap(sql.ErrNoRows))
+	fmt.Println(string(causes.Canonical(err)))
+
+	// Output:
+	// too_many_requests/order/limit_exceeded
+	// *errors.errorString: sql: no rows in result set
+}
+
+func ExampleCanonical_namedCause() {
+	err := ErrLookupFailed.
+		WithNamedCause("primary_db", context.DeadlineExceeded).
+		WithNamedCause("fallback_cache", ErrCacheNotFound.Wrap(io.EOF))
+	fmt.Println(string(causes.Canonical(err)))
+
+	// Output:
+	// unavailable/lookup/failed
+	// primary_db: context.deadlineExceededError: context deadline exceeded
+	// fallback_cache: not_found/cache/not_found
+	// fallback_cache: *errors.errorString: EOF
+}
+
+func ExampleDedup() {
+	dedup := causes.NewDedup(time.Minute)
+
+	for _, id := range []string{"ORD-1", "ORD-2"} {
+		err := ErrOrderLimitExceeded.Wrap(OrderLimitExceededDetail{
+			OrderID: id,
+			Limit:   10,
+		})
+		fmt.Println(dedup.Seen(err))
+	}
+
+	fmt.Println(dedup.Seen(ErrPayoutFrozen))
+
+	// Output:
+	// false
+	// true
+	// false
+}
@@ -0,0 +1,66 @@
+package causes_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var (
+	ErrOrderNotFound  = causes.Define(codes.NotFound, "order/not_found", "Order not found")
+	ErrOrderCancelled = causes.Define(codes.PreconditionFailed, "order/cancelled", "Order is cancelled")
+)
+
+func ExampleDefine() {
+	fmt.Println(ErrOrderNotFound)
+
+	defer func() {
+		fmt.Println(recover())
+	}()
+
+	causes.Define(codes.Conflict, "order/not_found", "Order not found")
+
+	// Output:
+	// Order not found
+	// causes: kind "order/not_found" is already defined with code not_found
+}
+
+func ExampleCatalog() {
+	b, err := json.MarshalIndent(causes.Catalog(), "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(b))
+
+	// Output:
+	// [
+	//  {
+	//   "kind": "order/cancelled",
+	//   "code": "precondition_failed",
+	//   "http": 400,
+	//   "message": "Order is cancelled"
+	//  },
+	//  {
+	//   "kind": "order/not_found",
+	//   "code": "not_found",
+	//   "http": 404,
+	//   "message": "Order not found"
+	//  }
+	// ]
+}
+
+func ExampleWriteCatalog() {
+	if err := causes.WriteCatalog(os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// | Kind | Code | HTTP | Message |
+	// | --- | --- | --- | --- |
+	// | `order/cancelled` | precondition_failed | 400 | Order is cancelled |
+	// | `order/not_found` | not_found | 404 | Order not found |
+}
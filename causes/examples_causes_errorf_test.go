@@ -0,0 +1,43 @@
+package causes_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+func ExampleErrorf() {
+	var err error = causes.Errorf("find order %q: %w", "ORD-42", sql.ErrNoRows)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, sql.ErrNoRows))
+
+	var d causes.Detail
+	if errors.As(err, &d) {
+		fmt.Println(d.Code())
+		fmt.Println(d.Kind())
+		fmt.Println(d.Unwrap())
+	}
+
+	err = causes.Errorf("read: %w, %w", io.EOF, io.ErrUnexpectedEOF)
+	fmt.Println(errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF))
+
+	// Like errors.New, each error is distinct.
+	errReset := causes.Std("connection reset")
+	errClosed := causes.Std("connection closed")
+	err = errReset.Wrap(io.EOF)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, errReset), errors.Is(err, errClosed))
+
+	// Output:
+	// find order "ORD-42": sql: no rows in result set
+	// true
+	// unknown
+	// unknown
+	// sql: no rows in result set
+	// true true
+	// connection reset
+	// true false
+}
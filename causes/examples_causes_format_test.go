@@ -0,0 +1,31 @@
+package causes_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func Example_format() {
+	err := ErrCheckoutFailed.Wrap(ErrInvoiceVoid.Wrap(InvoiceDetail{InvoiceID: "INV-42"}).Wrap(findInvoice()))
+	fmt.Printf("%v\n", err)
+	fmt.Printf("%q\n", err)
+	fmt.Printf("%+v\n", err)
+
+	// Output:
+	// Checkout failed
+	// "Checkout failed"
+	// conflict/checkout/failed: Checkout failed
+	// Caused by: precondition_failed/invoice/void: Invoice is void
+	//     data: {InvoiceID:INV-42}
+	// Caused by: Error: invoice not found: sql: no rows in result set
+	//     Origin is: invoice not found
+	//         at causes_test.findInvoice (in examples_causes_format_test.go:30)
+	//     Ends here:
+	//         at causes_test.Example_format (in examples_causes_format_test.go:11)
+}
+
+func findInvoice() error {
+	return stacktrace.Annotate(sql.ErrNoRows, "invoice not found")
+}
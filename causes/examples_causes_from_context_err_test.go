@@ -0,0 +1,31 @@
+package causes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+func ExampleFromContextErr() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := causes.FromContextErr(ctx.Err())
+	fmt.Println(err)
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(errors.Is(err, causes.ErrCanceled))
+	fmt.Println(errors.Is(err, context.Canceled))
+
+	err = causes.FromContextErr(context.DeadlineExceeded)
+	fmt.Println(causes.IsCode(err, codes.DeadlineExceeded))
+
+	// Output:
+	// Operation is canceled
+	// canceled
+	// true
+	// true
+	// true
+}
@@ -0,0 +1,40 @@
+package causes_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+func ExampleIsCode() {
+	err := fmt.Errorf("get document: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))
+	fmt.Println(causes.IsCode(err, codes.NotFound))
+	fmt.Println(causes.IsCode(err, codes.Conflict))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleHasKind() {
+	err := fmt.Errorf("get document: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))
+	fmt.Println(causes.HasKind(err, "document/not_found"))
+	fmt.Println(causes.HasKind(err, "user/not_found"))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleCodeOf() {
+	err := fmt.Errorf("get document: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))
+	fmt.Println(causes.CodeOf(err))
+	fmt.Println(causes.CodeOf(errors.New("bad")))
+
+	// Output:
+	// not_found
+	// unknown
+}
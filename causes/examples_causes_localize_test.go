@@ -0,0 +1,61 @@
+package causes_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleBundle_Localize() {
+	bundle := causes.NewBundle().
+		Add("ms", "checkout/failed", "Pembayaran gagal").
+		Add("ms", "order/limit_exceeded", "Pesanan %{OrderID} melebihi had %{Limit} barang")
+
+	err := ErrCheckoutFailed.Wrap(ErrOrderLimitExceeded.Wrap(OrderLimitExceededDetail{
+		OrderID: "ORD-42",
+		Limit:   10,
+	}))
+
+	localized := bundle.Localize(err, "ms-MY")
+	fmt.Println(localized)
+	fmt.Println(errors.Unwrap(localized))
+	fmt.Println(ErrOrderLimitExceeded.Is(localized))
+
+	// Locales without translations keep the original message.
+	fmt.Println(bundle.Localize(err, "ja"))
+
+	// Output:
+	// Pembayaran gagal
+	// Pesanan ORD-42 melebihi had 10 barang
+	// true
+	// Checkout failed
+}
+
+func ExampleBundle_Localize_wrapped() {
+	bundle := causes.NewBundle().
+		Add("ms", "checkout/failed", "Pembayaran gagal").
+		Add("ms", "document/not_found", "Dokumen tidak dijumpai")
+
+	err := fmt.Errorf("pay: %w", ErrCheckoutFailed.Wrap(
+		stacktrace.Wrap(fmt.Errorf("get receipt: %w", ErrDocumentNotFound.Wrap(sql.ErrNoRows))),
+	))
+
+	localized := bundle.Localize(err, "ms")
+	fmt.Println(localized)
+	fmt.Println(errors.Is(localized, ErrDocumentNotFound))
+
+	var d causes.Detail
+	if errors.As(localized, &d) {
+		fmt.Println(d.Message())
+		fmt.Println(d.Unwrap())
+	}
+
+	// Output:
+	// pay: Pembayaran gagal
+	// true
+	// Pembayaran gagal
+	// get receipt: Dokumen tidak dijumpai
+}
@@ -0,0 +1,29 @@
+package causes_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var ErrCartEmpty = causes.NewAuto(codes.PreconditionFailed, "cart_empty", "Cart is empty")
+
+func ExampleNewAuto() {
+	var err error = ErrCartEmpty
+	fmt.Println(errors.Is(err, ErrCartEmpty))
+
+	var d causes.Detail
+	if errors.As(err, &d) {
+		fmt.Println(d.Code())
+		fmt.Println(d.Kind())
+		fmt.Println(d.Message())
+	}
+
+	// Output:
+	// true
+	// precondition_failed
+	// github.com/alextanhongpin/errors/causes_test/cart_empty
+	// Cart is empty
+}
@@ -33,7 +33,7 @@ func (e *UserNotFoundError) Error() string {
 	return fmt.Sprintf("%s: %q", e.error.Error(), e.UserID)
 }
 
-func ExampleNewCustom() {
+func Example_newCustom() {
 	var err error = fmt.Errorf("%w: %w", NewUserNotFoundError("user-3173"), sql.ErrNoRows)
 	fmt.Println(errors.Is(err, ErrUserNotFound))
 
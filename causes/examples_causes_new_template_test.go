@@ -0,0 +1,78 @@
+package causes_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+type OrderLimitExceededDetail struct {
+	OrderID string
+	Limit   int
+}
+
+var ErrOrderLimitExceeded = causes.NewTemplate[OrderLimitExceededDetail](
+	codes.TooManyRequests,
+	"order/limit_exceeded",
+	"Order %{OrderID} exceeds the limit of %{Limit} items",
+)
+
+func ExampleNewTemplate() {
+	var err error = ErrOrderLimitExceeded.Wrap(OrderLimitExceededDetail{
+		OrderID: "ORD-42",
+		Limit:   10,
+	})
+	fmt.Println(err)
+	fmt.Println(ErrOrderLimitExceeded.Is(err))
+
+	var d causes.Detail
+	if errors.As(err, &d) {
+		fmt.Println(d.Code())
+		fmt.Println(d.Kind())
+	}
+
+	t, ok := ErrOrderLimitExceeded.Unwrap(err)
+	fmt.Printf("%+v\n", t)
+	fmt.Println(ok)
+
+	// Output:
+	// Order ORD-42 exceeds the limit of 10 items
+	// true
+	// too_many_requests
+	// order/limit_exceeded
+	// {OrderID:ORD-42 Limit:10}
+	// true
+}
+
+type Customer struct {
+	Name string
+}
+
+type OrderBlockedDetail struct {
+	*Customer
+	OrderID string
+}
+
+var ErrOrderBlocked = causes.NewTemplate[OrderBlockedDetail](
+	codes.Forbidden,
+	"order/blocked",
+	"Order %{OrderID} of %{Name} is blocked",
+)
+
+func ExampleNewTemplate_embedded() {
+	fmt.Println(ErrOrderBlocked.Wrap(OrderBlockedDetail{
+		Customer: &Customer{Name: "John"},
+		OrderID:  "ORD-42",
+	}))
+
+	// Placeholders through a nil embedded pointer are kept.
+	fmt.Println(ErrOrderBlocked.Wrap(OrderBlockedDetail{
+		OrderID: "ORD-42",
+	}))
+
+	// Output:
+	// Order ORD-42 of John is blocked
+	// Order ORD-42 of %{Name} is blocked
+}
@@ -0,0 +1,34 @@
+package causes_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var ErrAccountLocked = causes.New(codes.Forbidden, "account/locked", "Account is locked")
+
+func Example_nil() {
+	// Only enrich the error when the account is locked.
+	locked := false
+
+	cause := ErrAccountLocked
+	if !locked {
+		cause = nil
+	}
+
+	// Methods on a nil cause are safe no-ops.
+	err := cause.Wrap(sql.ErrNoRows)
+	fmt.Println(err)
+	fmt.Println(cause.Kind() == "")
+	fmt.Println(cause.Data())
+	fmt.Println(cause.Unwrap())
+
+	// Output:
+	// sql: no rows in result set
+	// true
+	// <nil>
+	// <nil>
+}
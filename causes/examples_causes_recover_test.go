@@ -0,0 +1,42 @@
+package causes_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleRecover() {
+	err := causes.RecoverFunc(func() error {
+		var m map[string]int
+		m["answer"] = 42
+
+		return nil
+	})
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, causes.ErrPanic))
+
+	var d causes.Detail
+	if errors.As(err, &d) {
+		fmt.Println(d.Code())
+		fmt.Println(d.Kind())
+		fmt.Println(d.Data())
+	}
+
+	fmt.Println(stacktrace.Sprint(d.Unwrap()))
+
+	// Output:
+	// panic: assignment to entry in nil map
+	// true
+	// internal
+	// panic
+	// assignment to entry in nil map
+	// Error: assignment to entry in nil map
+	//     Origin is:
+	//         at causes_test.ExampleRecover.func1 (in examples_causes_recover_test.go:14)
+	//         at causes.RecoverFunc (in recover.go:33)
+	//     Ends here:
+	//         at causes_test.ExampleRecover (in examples_causes_recover_test.go:12)
+}
@@ -0,0 +1,32 @@
+package causes_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/alextanhongpin/errors/causes"
+)
+
+func ExampleRepanic() {
+	defer func() {
+		// The original panic value is preserved.
+		fmt.Println(recover() == http.ErrAbortHandler)
+	}()
+
+	err := causes.RecoverFunc(func() error {
+		panic(http.ErrAbortHandler)
+	})
+	fmt.Println(causes.CodeOf(err))
+
+	// Errors matching ErrPanic that were not recovered do not panic.
+	causes.Repanic(causes.ErrPanic.Wrap(io.EOF))
+	fmt.Println("not recovered")
+
+	causes.Repanic(err)
+
+	// Output:
+	// internal
+	// not recovered
+	// true
+}
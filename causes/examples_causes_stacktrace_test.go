@@ -0,0 +1,59 @@
+package causes_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleStackTracer() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := ErrDocumentNotFound.Wrap(findDocument())
+	logger.Error("failed to find document", causes.Attr(err))
+
+	var data struct {
+		Error struct {
+			Kind       string
+			Stacktrace []stacktrace.Frame
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(data.Error.Kind)
+	for _, f := range data.Error.Stacktrace {
+		fmt.Printf("%q %s:%d\n", f.Cause, filepath.Base(f.File), f.Line)
+	}
+
+	// Output:
+	// document/not_found
+	// "document not found" examples_causes_stacktrace_test.go:45
+	// "" examples_causes_stacktrace_test.go:20
+}
+
+func findDocument() error {
+	return stacktrace.Annotate(sql.ErrNoRows, "document not found")
+}
+
+func ExampleStackTracer_nested() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	// The frames are only logged once, by the innermost cause.
+	err := ErrCheckoutFailed.Wrap(ErrDocumentNotFound.Wrap(findDocument()))
+	logger.Error("failed to checkout", causes.Attr(err))
+	fmt.Println(strings.Count(buf.String(), `"stacktrace"`))
+
+	// Output:
+	// 1
+}
@@ -0,0 +1,34 @@
+package causes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var (
+	ErrLookupFailed  = causes.New(codes.Unavailable, "lookup/failed", "Lookup failed")
+	ErrCacheNotFound = causes.New(codes.NotFound, "cache/not_found", "Cache entry not found")
+)
+
+func Example_withNamedCause() {
+	err := ErrLookupFailed.
+		WithNamedCause("primary_db", context.DeadlineExceeded).
+		WithNamedCause("fallback_cache", ErrCacheNotFound)
+
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, context.DeadlineExceeded))
+	fmt.Println(errors.Is(err, ErrCacheNotFound))
+	fmt.Println(causes.IsCode(err, codes.NotFound))
+
+	// Output:
+	// Lookup failed
+	// ├── primary_db: context deadline exceeded
+	// └── fallback_cache: Cache entry not found
+	// true
+	// true
+	// true
+}
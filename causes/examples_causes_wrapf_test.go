@@ -0,0 +1,19 @@
+package causes_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+func Example_wrapf() {
+	err := ErrDocumentNotFound.Wrapf(sql.ErrNoRows, "The document %q does not exists", "README.md")
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrDocumentNotFound))
+	fmt.Println(errors.Is(err, sql.ErrNoRows))
+
+	// Output:
+	// The document "README.md" does not exists
+	// true
+	// true
+}
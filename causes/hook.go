@@ -0,0 +1,46 @@
+package causes
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var hooks struct {
+	sync.RWMutex
+	fns []func(Detail)
+}
+
+// OnError registers a hook that is called whenever an error is created at
+// runtime, that is by Wrap, Wrapf and WithNamedCause, the Wrap of NewHint
+// and NewTemplate, Errorf and Recover. Errors declared with New, Std and Define are sentinels
+// and do not trigger the hook.
+//
+// Each error is reported once. The first Wrap of an error that was just
+// reported, e.g. Hint.Wrap(data).Wrap(err), is the same error and is not
+// reported again. As a result, a package variable declared with the Wrap of
+// a hint or with Errorf is reported when declared, instead of on its first
+// Wrap; all later Wraps are reported.
+//
+// Hooks are meant to be registered at init, e.g. to count errors by code.
+func OnError(fn func(Detail)) {
+	hooks.Lock()
+	hooks.fns = append(hooks.fns, fn)
+	hooks.Unlock()
+}
+
+func notify(d *errorDetail) *errorDetail {
+	d.pending = new(atomic.Bool)
+	d.pending.Store(true)
+
+	// Hooks are called without holding the lock, so that they may create
+	// errors or register other hooks.
+	hooks.RLock()
+	fns := hooks.fns
+	hooks.RUnlock()
+
+	for _, fn := range fns {
+		fn(d)
+	}
+
+	return d
+}
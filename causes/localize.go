@@ -0,0 +1,122 @@
+package causes
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// Bundle holds the translated messages of the causes, keyed by locale and
+// kind. The messages may reference fields of the data with %{Field}
+// placeholders, like NewTemplate.
+type Bundle struct {
+	mu       sync.RWMutex
+	messages map[string]map[string]string
+}
+
+// NewBundle returns an empty Bundle.
+func NewBundle() *Bundle {
+	return &Bundle{
+		messages: make(map[string]map[string]string),
+	}
+}
+
+// Add adds the message for the kind in the locale, e.g. "ms" or "ms-MY".
+func (b *Bundle) Add(locale, kind, msg string) *Bundle {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.messages[locale] == nil {
+		b.messages[locale] = make(map[string]string)
+	}
+	b.messages[locale][kind] = msg
+
+	return b
+}
+
+// Localize returns a copy of the error with the messages of the causes
+// replaced by the ones in the locale. A locale with a region, such as
+// "ms-MY", falls back to the base language "ms". Causes without a
+// translation keep their message.
+//
+// Wrappers around a cause, such as fmt.Errorf or stacktrace.Wrap, cannot be
+// copied. Their message is kept with the cause's part translated, but the
+// localized error only unwraps to the translated cause. It is meant to be
+// presented to users; log the original error instead.
+func (b *Bundle) Localize(err error, locale string) error {
+	var c *errorDetail
+	if !errors.As(err, &c) || c == nil {
+		return err
+	}
+
+	lc := b.localize(c, locale)
+	if err == error(c) {
+		return lc
+	}
+
+	// Keep the context added by the wrappers around the cause.
+	msg := err.Error()
+	old := c.Error()
+	if i := strings.LastIndex(msg, old); i >= 0 {
+		msg = msg[:i] + lc.Error() + msg[i+len(old):]
+	} else {
+		msg = lc.Error()
+	}
+
+	return &localizedError{
+		msg: msg,
+		err: lc,
+	}
+}
+
+func (b *Bundle) localize(c *errorDetail, locale string) *errorDetail {
+	cp := *c
+	if msg, ok := b.message(locale, c.kind); ok {
+		cp.msg = interpolate(msg, c.data)
+	}
+	cp.err = b.Localize(c.err, locale)
+
+	if len(c.branches) > 0 {
+		cp.branches = make([]branch, len(c.branches))
+		for i, br := range c.branches {
+			cp.branches[i] = branch{
+				label: br.label,
+				err:   b.Localize(br.err, locale),
+			}
+		}
+	}
+
+	return &cp
+}
+
+// localizedError keeps the message of the wrappers around a localized cause.
+type localizedError struct {
+	msg string
+	err error
+}
+
+func (e *localizedError) Error() string {
+	return e.msg
+}
+
+func (e *localizedError) Unwrap() error {
+	return e.err
+}
+
+func (b *Bundle) message(locale, kind string) (string, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for {
+		if msg, ok := b.messages[locale][kind]; ok {
+			return msg, true
+		}
+
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			return "", false
+		}
+
+		locale = locale[:i]
+	}
+}
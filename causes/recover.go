@@ -0,0 +1,65 @@
+package causes
+
+import (
+	"fmt"
+
+	"github.com/alextanhongpin/errors/codes"
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+// ErrPanic matches errors converted from a recovered panic.
+var ErrPanic = New(codes.Internal, "panic", "Panic recovered")
+
+// Skip [newPanic, Recover].
+var panicTrace = stacktrace.Caller(2)
+
+// Recover converts a panic into an Internal error and assigns it to err. The
+// panic value is stored as the data, and the stacktrace from the point of
+// panic is captured.
+//
+// Recover must be called directly by defer:
+//
+//	defer causes.Recover(&err)
+func Recover(err *error) {
+	if v := recover(); v != nil {
+		*err = newPanic(v)
+	}
+}
+
+// RecoverFunc calls fn, converting any panic into an error.
+func RecoverFunc(fn func() error) (err error) {
+	defer Recover(&err)
+
+	return fn()
+}
+
+// Repanic panics with the original panic value if err was converted from a
+// recovered panic, so that values such as http.ErrAbortHandler reach the
+// frameworks that rely on them. Otherwise it does nothing.
+func Repanic(err error) {
+	var v any
+	if walk(err, func(d *errorDetail) bool {
+		v = d.data
+		return d.recovered
+	}) {
+		panic(v)
+	}
+}
+
+func newPanic(v any) *errorDetail {
+	var err error
+	if e, ok := v.(error); ok {
+		err = panicTrace.Wrap(e)
+	} else {
+		err = panicTrace.New("%v", v)
+	}
+
+	return notify(&errorDetail{
+		code:      ErrPanic.code,
+		kind:      ErrPanic.kind,
+		msg:       fmt.Sprintf("panic: %v", v),
+		data:      v,
+		err:       err,
+		recovered: true,
+	})
+}
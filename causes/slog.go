@@ -0,0 +1,67 @@
+package causes
+
+import (
+	"errors"
+	"log/slog"
+)
+
+// Attr returns the error as a slog.Attr with the key "error". If the error
+// wraps a cause, the cause is logged as structured fields.
+//
+//	logger.Error("failed to pay", causes.Attr(err))
+func Attr(err error) slog.Attr {
+	if _, ok := err.(slog.LogValuer); ok {
+		return slog.Any("error", err)
+	}
+
+	var c *errorDetail
+	if errors.As(err, &c) {
+		return slog.Group("error",
+			slog.String("message", err.Error()),
+			slog.Any("cause", c),
+		)
+	}
+
+	return slog.Any("error", err)
+}
+
+// Args returns the error as arguments for slog.Logger methods.
+func Args(err error) []any {
+	return []any{Attr(err)}
+}
+
+func (c *errorDetail) LogValue() slog.Value {
+	if c == nil {
+		return slog.Value{}
+	}
+
+	attrs := []slog.Attr{
+		slog.String("code", c.code.String()),
+		slog.String("kind", c.kind),
+		slog.String("message", c.msg),
+	}
+	if c.data != nil {
+		attrs = append(attrs, slog.Any("data", c.data))
+	}
+	if c.err != nil {
+		attrs = append(attrs, slog.Any("cause", c.err))
+	}
+	// The frames are only logged by the innermost cause that has them,
+	// instead of once per nested cause.
+	if frames := stackTrace(c.err); len(frames) > 0 && !walk(c.err, hasStackTrace) {
+		attrs = append(attrs, slog.Any("stacktrace", frames))
+	}
+	if len(c.branches) > 0 {
+		branches := make([]slog.Attr, len(c.branches))
+		for i, b := range c.branches {
+			branches[i] = slog.Any(b.label, b.err)
+		}
+
+		attrs = append(attrs, slog.Attr{
+			Key:   "causes",
+			Value: slog.GroupValue(branches...),
+		})
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,27 @@
+package causes
+
+import (
+	"errors"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+// StackTracer is implemented by errors that carry their own frames. Errors
+// created by the stacktrace package are recognized without implementing it.
+type StackTracer interface {
+	StackTrace() []stacktrace.Frame
+}
+
+// stackTrace returns the frames captured in the chain of err, if any.
+func stackTrace(err error) []stacktrace.Frame {
+	var st StackTracer
+	if errors.As(err, &st) {
+		return st.StackTrace()
+	}
+
+	return stacktrace.Frames(err)
+}
+
+func hasStackTrace(c *errorDetail) bool {
+	return len(stackTrace(c.err)) > 0
+}
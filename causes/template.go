@@ -0,0 +1,76 @@
+package causes
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+var placeholder = regexp.MustCompile(`%\{(\w+)\}`)
+
+// NewTemplate is like NewHint, but the message may reference fields of the
+// hinted struct with %{Field} placeholders, which are interpolated on Wrap.
+//
+// NewTemplate panics if T is not a struct, or if a placeholder does not
+// refer to an exported field of T.
+func NewTemplate[T any](code codes.Code, kind, msg string) hint[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("causes: template type %s is not a struct", t))
+	}
+
+	for _, m := range placeholder.FindAllStringSubmatch(msg, -1) {
+		f, ok := t.FieldByName(m[1])
+		if !ok || !f.IsExported() {
+			panic(fmt.Sprintf("causes: template field %q not found in %s", m[1], t))
+		}
+	}
+
+	return &errorTemplate[T]{
+		errorHint: errorHint[T]{
+			err: &errorDetail{
+				code: code,
+				kind: kind,
+				msg:  msg,
+			},
+		},
+	}
+}
+
+type errorTemplate[T any] struct {
+	errorHint[T]
+}
+
+func (e *errorTemplate[T]) Wrap(t T) *errorDetail {
+	cp := e.wrap(t)
+	cp.msg = interpolate(cp.msg, t)
+
+	return notify(cp)
+}
+
+// interpolate replaces the %{Field} placeholders in msg with the fields of
+// data. Placeholders that do not refer to a field are kept as is.
+func interpolate(msg string, data any) string {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Struct {
+		return msg
+	}
+
+	return placeholder.ReplaceAllStringFunc(msg, func(s string) string {
+		name := placeholder.FindStringSubmatch(s)[1]
+		f, ok := v.Type().FieldByName(name)
+		if !ok || !f.IsExported() {
+			return s
+		}
+
+		// Promoted fields through a nil embedded pointer are not set.
+		fv, err := v.FieldByIndexErr(f.Index)
+		if err != nil {
+			return s
+		}
+
+		return fmt.Sprint(fv.Interface())
+	})
+}
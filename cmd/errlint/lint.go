@@ -0,0 +1,226 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const causesPath = "github.com/alextanhongpin/errors/causes"
+
+// argsByFunc is the index of the kind and message arguments of the
+// constructors in the causes package. A negative index means the argument is
+// absent.
+var argsByFunc = map[string][2]int{
+	"New":         {1, 2},
+	"Define":      {1, 2},
+	"NewHint":     {1, 2},
+	"NewTemplate": {1, 2},
+	"NewAuto":     {-1, 2},
+}
+
+type issue struct {
+	pos token.Position
+	msg string
+}
+
+func (i issue) String() string {
+	return fmt.Sprintf("%s: %s", i.pos, i.msg)
+}
+
+type definition struct {
+	name string
+	pos  token.Pos
+}
+
+// lint reports the issues of the causes defined in the Go files under root.
+func lint(root string, tests bool) ([]issue, error) {
+	fset := token.NewFileSet()
+
+	var files []*ast.File
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := d.Name()
+		if d.IsDir() {
+			if path != root && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || (!tests && strings.HasSuffix(name, "_test.go")) {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		issues []issue
+		defs   []definition
+		kinds  = make(map[string]token.Pos)
+	)
+
+	for _, f := range files {
+		pkg := importName(f)
+		if pkg == "" {
+			continue
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ValueSpec:
+				for i, v := range n.Values {
+					if _, ok := constructor(pkg, v); ok && i < len(n.Names) && n.Names[i].Name != "_" {
+						defs = append(defs, definition{name: n.Names[i].Name, pos: n.Names[i].Pos()})
+					}
+				}
+			case *ast.CallExpr:
+				args, ok := constructor(pkg, n)
+				if !ok {
+					return true
+				}
+
+				if kind, pos, ok := stringArg(n, args[0]); ok {
+					if prev, ok := kinds[kind]; ok {
+						issues = append(issues, issue{
+							pos: fset.Position(pos),
+							msg: fmt.Sprintf("duplicate kind %q, first defined at %s", kind, fset.Position(prev)),
+						})
+					} else {
+						kinds[kind] = pos
+					}
+				}
+
+				if msg, pos, ok := stringArg(n, args[1]); ok && strings.ContainsAny(msg[max(len(msg)-1, 0):], ".!?") {
+					issues = append(issues, issue{
+						pos: fset.Position(pos),
+						msg: fmt.Sprintf("message %q should not end with punctuation", msg),
+					})
+				}
+			}
+
+			return true
+		})
+	}
+
+	// References are matched by name, which is good enough for package level
+	// variables.
+	refs := make(map[string]int)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				refs[id.Name]++
+			}
+
+			return true
+		})
+	}
+
+	for _, d := range defs {
+		if refs[d.name] <= 1 {
+			issues = append(issues, issue{
+				pos: fset.Position(d.pos),
+				msg: fmt.Sprintf("%s is defined but not used", d.name),
+			})
+		}
+	}
+
+	slices.SortFunc(issues, func(a, b issue) int {
+		if a.pos.Filename != b.pos.Filename {
+			return cmp.Compare(a.pos.Filename, b.pos.Filename)
+		}
+		if a.pos.Line != b.pos.Line {
+			return cmp.Compare(a.pos.Line, b.pos.Line)
+		}
+
+		return cmp.Compare(a.pos.Column, b.pos.Column)
+	})
+
+	return issues, nil
+}
+
+// importName returns the name the causes package is imported as, or empty
+// if it is not imported.
+func importName(f *ast.File) string {
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != causesPath {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+
+		return "causes"
+	}
+
+	return ""
+}
+
+// constructor returns the argument indices if expr calls a constructor from
+// the causes package, including the generic ones.
+func constructor(pkg string, expr ast.Expr) ([2]int, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return [2]int{}, false
+	}
+
+	fun := call.Fun
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	sel, ok := fun.(*ast.SelectorExpr)
+	if !ok {
+		return [2]int{}, false
+	}
+
+	id, ok := sel.X.(*ast.Ident)
+	if !ok || id.Name != pkg {
+		return [2]int{}, false
+	}
+
+	args, ok := argsByFunc[sel.Sel.Name]
+	return args, ok
+}
+
+func stringArg(call *ast.CallExpr, i int) (string, token.Pos, bool) {
+	if i < 0 || i >= len(call.Args) {
+		return "", token.NoPos, false
+	}
+
+	lit, ok := call.Args[i].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", token.NoPos, false
+	}
+
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", token.NoPos, false
+	}
+
+	return s, lit.Pos(), true
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	issues, err := lint(filepath.Join("testdata", "src"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`testdata/src/errors.go:13:65: message "Order not found." should not end with punctuation`,
+		`testdata/src/errors.go:15:46: duplicate kind "order/not_found", first defined at testdata/src/errors.go:13:46`,
+		`testdata/src/errors.go:16:2: ErrOrderUnused is defined but not used`,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("want %d issues, got %v", len(want), issues)
+	}
+
+	for i, w := range want {
+		if got := issues[i].String(); got != w {
+			t.Errorf("issue %d:\nwant %s\ngot  %s", i, w, got)
+		}
+	}
+}
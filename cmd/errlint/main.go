@@ -0,0 +1,36 @@
+// Command errlint checks the causes defined in a module for duplicate
+// kinds, unused definitions and messages ending with punctuation.
+//
+// Usage:
+//
+//	errlint [-tests] [dir]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	tests := flag.Bool("tests", false, "include _test.go files")
+	flag.Parse()
+
+	root := "."
+	if flag.NArg() > 0 {
+		root = flag.Arg(0)
+	}
+
+	issues, err := lint(root, *tests)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	for _, i := range issues {
+		fmt.Println(i)
+	}
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
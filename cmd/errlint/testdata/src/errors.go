@@ -0,0 +1,25 @@
+package src
+
+import (
+	errs "github.com/alextanhongpin/errors/causes"
+	"github.com/alextanhongpin/errors/codes"
+)
+
+type OrderDetail struct {
+	OrderID string
+}
+
+var (
+	ErrOrderNotFound = errs.New(codes.NotFound, "order/not_found", "Order not found.")
+	ErrOrderVoid     = errs.NewHint[OrderDetail](codes.PreconditionFailed, "order/void", "Order is void")
+	ErrOrderMissing  = errs.New(codes.NotFound, "order/not_found", "Order is missing")
+	ErrOrderUnused   = errs.Define(codes.Internal, "order/unused", "Order is unused")
+)
+
+func FindOrder(id string) error {
+	if id == "" {
+		return ErrOrderMissing
+	}
+
+	return ErrOrderVoid.Wrap(OrderDetail{OrderID: id}).Wrap(ErrOrderNotFound)
+}
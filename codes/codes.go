@@ -1,10 +1,13 @@
 package codes
 
-import "strings"
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
 
 type Code int
 
-//go:generate stringer -type=Code -linecomment
 const (
 	unknown Code = iota
 
@@ -27,8 +30,81 @@ const (
 	Unknown            // unknown
 )
 
+// names holds the predefined codes, which are looked up without locking.
+var names = [...]string{
+	unknown:            "unknown",
+	Aborted:            "aborted",
+	BadRequest:         "bad_request",
+	Canceled:           "canceled",
+	Conflict:           "conflict",
+	DataLoss:           "data_loss",
+	DeadlineExceeded:   "deadline_exceeded",
+	Exists:             "exists",
+	Forbidden:          "forbidden",
+	Internal:           "internal",
+	NotFound:           "not_found",
+	NotImplemented:     "not_implemented",
+	OutOfRange:         "out_of_range",
+	PreconditionFailed: "precondition_failed",
+	TooManyRequests:    "too_many_requests",
+	Unauthorized:       "unauthorized",
+	Unavailable:        "unavailable",
+	Unknown:            "unknown",
+}
+
+// nameByCode holds the predefined and registered custom codes.
+var nameByCode = make(map[Code]string)
+
+func init() {
+	for c, name := range names {
+		nameByCode[Code(c)] = name
+	}
+}
+
+func (c Code) String() string {
+	if c >= unknown && c <= Unknown {
+		return names[c]
+	}
+
+	if c >= firstCustom {
+		mu.RLock()
+		v, ok := nameByCode[c]
+		mu.RUnlock()
+		if ok {
+			return v
+		}
+	}
+
+	return "Code(" + strconv.Itoa(int(c)) + ")"
+}
+
 func (c Code) Valid() bool {
-	return c > unknown && c <= Unknown
+	if c > unknown && c <= Unknown {
+		return true
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	_, ok := nameByCode[c]
+	return ok && c >= firstCustom
+}
+
+// All returns all valid codes, including registered custom codes, in
+// ascending order.
+func All() []Code {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	res := make([]Code, 0, len(nameByCode))
+	for c := range nameByCode {
+		if c != unknown {
+			res = append(res, c)
+		}
+	}
+	slices.Sort(res)
+
+	return res
 }
 
 func Canonical(c Code) string {
@@ -56,7 +132,9 @@ var textByCode = map[Code]string{
 }
 
 func Text(c Code) string {
+	mu.RLock()
 	v, ok := textByCode[c]
+	mu.RUnlock()
 	if ok {
 		return v
 	}
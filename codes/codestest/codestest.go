@@ -0,0 +1,20 @@
+// package codestest provides test helpers for code mappings.
+package codestest
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+// Exhaustive fails the test for every code that is not handled. Use it to
+// ensure mappers cover new codes instead of falling into a default branch.
+func Exhaustive(t testing.TB, handled func(codes.Code) bool) {
+	t.Helper()
+
+	for _, c := range codes.All() {
+		if !handled(c) {
+			t.Errorf("codes: %s is not handled", c)
+		}
+	}
+}
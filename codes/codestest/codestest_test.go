@@ -0,0 +1,34 @@
+package codestest_test
+
+import (
+	"testing"
+
+	"github.com/alextanhongpin/errors/codes"
+	"github.com/alextanhongpin/errors/codes/codestest"
+)
+
+func severity(c codes.Code) (string, bool) {
+	switch c {
+	case codes.Aborted, codes.Canceled, codes.Conflict, codes.Exists:
+		return "conflict", true
+	case codes.BadRequest, codes.OutOfRange, codes.PreconditionFailed:
+		return "invalid", true
+	case codes.Forbidden, codes.Unauthorized:
+		return "denied", true
+	case codes.NotFound, codes.NotImplemented:
+		return "missing", true
+	case codes.TooManyRequests, codes.Unavailable, codes.DeadlineExceeded:
+		return "retry", true
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		return "failed", true
+	default:
+		return "", false
+	}
+}
+
+func TestExhaustive(t *testing.T) {
+	codestest.Exhaustive(t, func(c codes.Code) bool {
+		_, ok := severity(c)
+		return ok
+	})
+}
@@ -28,6 +28,9 @@ var httpStatusByCode = map[Code]int{
 
 // ̱HTTP returns the HTTP status code for the given error code.
 func HTTP(code Code) int {
+	mu.RLock()
+	defer mu.RUnlock()
+
 	status, ok := httpStatusByCode[code]
 	if !ok {
 		return http.StatusInternalServerError
@@ -35,6 +38,50 @@ func HTTP(code Code) int {
 	return status
 }
 
+// codeByHTTP resolves the HTTP status codes shared by multiple codes.
+var codeByHTTP = map[int]Code{
+	http.StatusBadRequest:          BadRequest,
+	http.StatusUnauthorized:        Unauthorized,
+	http.StatusForbidden:           Forbidden,
+	http.StatusNotFound:            NotFound,
+	http.StatusConflict:            Conflict,
+	http.StatusPreconditionFailed:  PreconditionFailed,
+	http.StatusTooManyRequests:     TooManyRequests,
+	499:                            Canceled,
+	http.StatusInternalServerError: Internal,
+	http.StatusNotImplemented:      NotImplemented,
+	http.StatusServiceUnavailable:  Unavailable,
+	http.StatusGatewayTimeout:      DeadlineExceeded,
+}
+
+// FromHTTP returns the error code for the given HTTP status code. Statuses
+// without a matching code fall back to BadRequest for 4xx, and Unknown
+// otherwise.
+func FromHTTP(status int) Code {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if c, ok := codeByHTTP[status]; ok {
+		return c
+	}
+
+	// Custom codes registered with WithHTTP.
+	match := unknown
+	for c, s := range httpStatusByCode {
+		if s == status && (match == unknown || c < match) {
+			match = c
+		}
+	}
+	if match != unknown {
+		return match
+	}
+
+	if status >= 400 && status < 500 {
+		return BadRequest
+	}
+	return Unknown
+}
+
 // https://chromium.googlesource.com/external/github.com/grpc/grpc/+/refs/tags/v1.21.4-pre1/doc/statuscodes.md
 var grpcByCode = map[Code]codes.Code{
 	Aborted:            codes.Aborted,
@@ -58,6 +105,9 @@ var grpcByCode = map[Code]codes.Code{
 
 // GRPC returns the gRPC code for the given error code.
 func GRPC(code Code) codes.Code {
+	mu.RLock()
+	defer mu.RUnlock()
+
 	c, ok := grpcByCode[code]
 	if !ok {
 		return codes.Internal
@@ -75,7 +125,9 @@ var codeByGRPC = func() map[codes.Code]Code {
 
 // GRPCToHTTP returns the HTTP code for the given grpc code.
 func GRPCToHTTP(code codes.Code) int {
+	mu.RLock()
 	c, ok := codeByGRPC[code]
+	mu.RUnlock()
 	if !ok {
 		return http.StatusInternalServerError
 	}
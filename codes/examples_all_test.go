@@ -0,0 +1,36 @@
+package codes_test
+
+import (
+	"fmt"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+func ExampleAll() {
+	for _, c := range codes.All() {
+		if c == PaymentRequired {
+			break
+		}
+
+		fmt.Println(c)
+	}
+
+	// Output:
+	// aborted
+	// bad_request
+	// canceled
+	// conflict
+	// data_loss
+	// deadline_exceeded
+	// exists
+	// forbidden
+	// internal
+	// not_found
+	// not_implemented
+	// out_of_range
+	// precondition_failed
+	// too_many_requests
+	// unauthorized
+	// unavailable
+	// unknown
+}
@@ -0,0 +1,23 @@
+package codes_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/alextanhongpin/errors/codes"
+)
+
+func ExampleFromHTTP() {
+	fmt.Println(codes.FromHTTP(http.StatusNotFound))
+	fmt.Println(codes.FromHTTP(http.StatusConflict))
+	fmt.Println(codes.FromHTTP(http.StatusPaymentRequired))
+	fmt.Println(codes.FromHTTP(http.StatusTeapot))
+	fmt.Println(codes.FromHTTP(http.StatusBadGateway))
+
+	// Output:
+	// not_found
+	// conflict
+	// payment_required
+	// bad_request
+	// unknown
+}
@@ -0,0 +1,36 @@
+package codes_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/alextanhongpin/errors/codes"
+	grpccodes "google.golang.org/grpc/codes"
+)
+
+var PaymentRequired = codes.New("payment_required",
+	codes.WithText("Payment Required"),
+	codes.WithHTTP(http.StatusPaymentRequired),
+	codes.WithGRPC(grpccodes.FailedPrecondition),
+)
+
+func ExampleNew() {
+	fmt.Println(PaymentRequired)
+	fmt.Println(PaymentRequired.Valid())
+	fmt.Println(codes.Canonical(PaymentRequired))
+	fmt.Println(codes.Text(PaymentRequired))
+	fmt.Println(codes.HTTP(PaymentRequired))
+	fmt.Println(codes.GRPC(PaymentRequired))
+
+	// Predefined codes are unchanged.
+	fmt.Println(codes.GRPCToHTTP(grpccodes.FailedPrecondition))
+
+	// Output:
+	// payment_required
+	// true
+	// PAYMENT_REQUIRED
+	// Payment Required
+	// 402
+	// FailedPrecondition
+	// 400
+}
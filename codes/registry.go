@@ -0,0 +1,89 @@
+package codes
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Codes below firstCustom are reserved for the predefined codes.
+const firstCustom Code = 1000
+
+var (
+	mu   sync.RWMutex
+	next = firstCustom
+)
+
+type registration struct {
+	text string
+	http int
+	grpc codes.Code
+}
+
+// Option configures a code registered with New.
+type Option func(*registration)
+
+// WithText sets the human readable text returned by Text.
+func WithText(text string) Option {
+	return func(r *registration) {
+		r.text = text
+	}
+}
+
+// WithHTTP sets the HTTP status code returned by HTTP. Defaults to 500.
+func WithHTTP(status int) Option {
+	return func(r *registration) {
+		r.http = status
+	}
+}
+
+// WithGRPC sets the gRPC code returned by GRPC. Defaults to Internal.
+func WithGRPC(code codes.Code) Option {
+	return func(r *registration) {
+		r.grpc = code
+	}
+}
+
+// New registers a new code with the given name, which is returned by
+// String. Custom codes are allocated above the range reserved for the
+// predefined codes.
+//
+// New is safe for concurrent use, and is meant to be called when
+// initializing package variables. It panics if the name is already taken.
+func New(name string, opts ...Option) Code {
+	r := &registration{
+		text: name,
+		http: http.StatusInternalServerError,
+		grpc: codes.Internal,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for c, n := range nameByCode {
+		// The unknown zero value shares the name with Unknown.
+		if n == name && c != unknown {
+			panic(fmt.Sprintf("codes: duplicate code name %q", name))
+		}
+	}
+
+	c := next
+	next++
+
+	nameByCode[c] = name
+	textByCode[c] = r.text
+	httpStatusByCode[c] = r.http
+	grpcByCode[c] = r.grpc
+
+	// Predefined codes take precedence when converting from gRPC.
+	if _, ok := codeByGRPC[r.grpc]; !ok {
+		codeByGRPC[r.grpc] = c
+	}
+
+	return c
+}
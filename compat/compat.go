@@ -0,0 +1,111 @@
+// package compat provides the github.com/pkg/errors API on top of the
+// stacktrace package, so that call sites can be migrated by only replacing
+// the import.
+package compat
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+// Skip [New, Errorf, WithStack, Wrap, Wrapf].
+var trace = stacktrace.Caller(1)
+
+// New returns an error with the message and the stacktrace.
+func New(msg string) error {
+	return trace.New("%s", msg)
+}
+
+// Errorf returns an error with the formatted message and the stacktrace.
+func Errorf(format string, args ...any) error {
+	return trace.New(format, args...)
+}
+
+// WithStack annotates err with the stacktrace. It returns nil if err is
+// nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return trace.Wrap(err)
+}
+
+// Wrap annotates err with the message and the stacktrace. It returns nil if
+// err is nil.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return trace.Annotate(err, msg)
+}
+
+// Wrapf is like Wrap, but with a formatted message.
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	return trace.Annotate(err, fmt.Sprintf(format, args...))
+}
+
+// WithMessage annotates err with the message, without the stacktrace. It
+// returns nil if err is nil.
+func WithMessage(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// WithMessagef is like WithMessage, but with a formatted message.
+func WithMessagef(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err)
+}
+
+// Cause returns the underlying cause of the error. Both the Cause method from
+// github.com/pkg/errors and Unwrap are followed.
+func Cause(err error) error {
+	type causer interface {
+		Cause() error
+	}
+
+	for err != nil {
+		var next error
+		if c, ok := err.(causer); ok {
+			next = c.Cause()
+		} else {
+			next = errors.Unwrap(err)
+		}
+		if next == nil {
+			break
+		}
+
+		err = next
+	}
+
+	return err
+}
+
+// Is reports whether any error in err's chain matches target.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's chain that matches target.
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
+
+// Unwrap returns the result of calling the Unwrap method on err.
+func Unwrap(err error) error {
+	return errors.Unwrap(err)
+}
@@ -0,0 +1,30 @@
+package compat_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	errors "github.com/alextanhongpin/errors/compat"
+)
+
+func Example() {
+	err := errors.Wrap(findOrder(), "checkout failed")
+	fmt.Printf("%v\n", err)
+	fmt.Printf("%+v\n", err)
+	fmt.Println(errors.Cause(err) == sql.ErrNoRows)
+	fmt.Println(errors.Wrap(nil, "checkout failed"))
+
+	// Output:
+	// checkout failed: order 42 not found: sql: no rows in result set
+	// Error: checkout failed: order 42 not found: sql: no rows in result set
+	//     Origin is: order 42 not found
+	//         at compat_test.findOrder (in examples_compat_test.go:29)
+	//     Ends here: checkout failed
+	//         at compat_test.Example (in examples_compat_test.go:11)
+	// true
+	// <nil>
+}
+
+func findOrder() error {
+	return errors.Wrapf(sql.ErrNoRows, "order %d not found", 42)
+}
@@ -1,6 +1,6 @@
 module github.com/alextanhongpin/errors
 
-go 1.20
+go 1.21
 
 require google.golang.org/grpc v1.56.2
 
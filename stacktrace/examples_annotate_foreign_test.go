@@ -0,0 +1,43 @@
+package stacktrace_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+// driverError is an error from a third-party library, which wraps errors
+// without a stacktrace.
+type driverError struct {
+	err error
+}
+
+func (e *driverError) Error() string {
+	return "driver: " + e.err.Error()
+}
+
+func (e *driverError) Unwrap() error {
+	return e.err
+}
+
+func ExampleAnnotate_foreign() {
+	err := stacktrace.Annotate(queryDriver(), "list orders failed")
+	fmt.Println(stacktrace.Sprint(err))
+
+	// Output:
+	// Error: list orders failed: driver: scan rows: sql: no rows in result set
+	//     Origin is: scan rows
+	//         at stacktrace_test.scanRows (in examples_annotate_foreign_test.go:42)
+	//         at stacktrace_test.queryDriver (in examples_annotate_foreign_test.go:38)
+	//     Ends here: list orders failed
+	//         at stacktrace_test.ExampleAnnotate_foreign (in examples_annotate_foreign_test.go:25)
+}
+
+func queryDriver() error {
+	return &driverError{err: scanRows()}
+}
+
+func scanRows() error {
+	return stacktrace.Annotate(sql.ErrNoRows, "scan rows")
+}
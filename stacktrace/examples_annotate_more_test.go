@@ -7,7 +7,7 @@ import (
 	"github.com/alextanhongpin/errors/stacktrace"
 )
 
-func ExampleAnnotateMore() {
+func ExampleAnnotate_more() {
 	err := findProduct(42)
 	err = stacktrace.Annotate(err, "failed to find product")
 
@@ -20,15 +20,15 @@ func ExampleAnnotateMore() {
 	// Error: failed to find product: product id "42": sql: no rows in result set
 	//     Origin is: product id "42"
 	//         at stacktrace_test.findProduct (in examples_annotate_more_test.go:37)
-	//         at stacktrace_test.ExampleAnnotateMore (in examples_annotate_more_test.go:11)
+	//         at stacktrace_test.ExampleAnnotate_more (in examples_annotate_more_test.go:11)
 	//     Ends here: failed to find product
-	//         at stacktrace_test.ExampleAnnotateMore (in examples_annotate_more_test.go:12)
+	//         at stacktrace_test.ExampleAnnotate_more (in examples_annotate_more_test.go:12)
 	//
 	// Reversed:
 	// Error: failed to find product: product id "42": sql: no rows in result set
 	//     Ends here: failed to find product
-	//         at stacktrace_test.ExampleAnnotateMore (in examples_annotate_more_test.go:12)
-	//         at stacktrace_test.ExampleAnnotateMore (in examples_annotate_more_test.go:11)
+	//         at stacktrace_test.ExampleAnnotate_more (in examples_annotate_more_test.go:12)
+	//         at stacktrace_test.ExampleAnnotate_more (in examples_annotate_more_test.go:11)
 	//     Origin is: product id "42"
 	//         at stacktrace_test.findProduct (in examples_annotate_more_test.go:37)
 }
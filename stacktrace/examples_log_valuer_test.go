@@ -0,0 +1,49 @@
+package stacktrace_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleLogValuer() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := stacktrace.Annotate(findAccount(), "login failed")
+	logger.Error("failed to login", slog.Any("error", stacktrace.LogValuer(err)))
+
+	var data struct {
+		Error struct {
+			Message string
+			Frames  []struct {
+				Role  string
+				Cause string
+				File  string
+				Line  int
+			}
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(data.Error.Message)
+	for _, f := range data.Error.Frames {
+		fmt.Printf("%s %q %s:%d\n", f.Role, f.Cause, filepath.Base(f.File), f.Line)
+	}
+
+	// Output:
+	// login failed: account not found: sql: no rows in result set
+	// origin "account not found" examples_log_valuer_test.go:48
+	// ends_here "login failed" examples_log_valuer_test.go:18
+}
+
+func findAccount() error {
+	return stacktrace.Annotate(sql.ErrNoRows, "account not found")
+}
@@ -0,0 +1,55 @@
+package stacktrace_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleMarshalJSON() {
+	err := stacktrace.Annotate(findOrder(), "checkout failed")
+
+	b, err := stacktrace.MarshalJSON(err)
+	if err != nil {
+		panic(err)
+	}
+
+	var data struct {
+		Error  string
+		Frames []struct {
+			Role     string
+			Cause    string
+			File     string
+			Line     int
+			Function string
+		}
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(data.Error)
+	for _, f := range data.Frames {
+		fmt.Printf("%s %q %s:%d\n", f.Role, f.Cause, filepath.Base(f.File), f.Line)
+	}
+
+	// Output:
+	// checkout failed: order not found: sql: no rows in result set
+	// origin "order not found" examples_marshal_json_test.go:54
+	// ends_here "checkout failed" examples_marshal_json_test.go:13
+}
+
+func ExampleSprintln() {
+	err := stacktrace.Annotate(findOrder(), "checkout failed")
+	fmt.Println(stacktrace.Sprintln(err))
+
+	// Output:
+	// Error: checkout failed: order not found: sql: no rows in result set; Origin is: order not found at stacktrace_test.findOrder (in examples_marshal_json_test.go:54); Ends here: checkout failed at stacktrace_test.ExampleSprintln (in examples_marshal_json_test.go:46)
+}
+
+func findOrder() error {
+	return stacktrace.Annotate(sql.ErrNoRows, "order not found")
+}
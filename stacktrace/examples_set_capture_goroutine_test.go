@@ -0,0 +1,38 @@
+package stacktrace_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleSetCaptureGoroutine() {
+	stacktrace.SetCaptureGoroutine(true)
+	defer stacktrace.SetCaptureGoroutine(false)
+
+	ch := make(chan error)
+	go func() {
+		ch <- stacktrace.New("worker failed")
+	}()
+
+	err := stacktrace.Annotate(<-ch, "pipeline failed")
+
+	frames := stacktrace.Frames(err)
+	worker, pipeline := frames[0], frames[len(frames)-1]
+	fmt.Println(worker.Cause, worker.Goroutine != 0)
+	fmt.Println(pipeline.Cause, pipeline.Goroutine != 0)
+	fmt.Println(worker.Goroutine != pipeline.Goroutine)
+
+	// Disabled by default.
+	stacktrace.SetCaptureGoroutine(false)
+
+	err = stacktrace.Wrap(errors.New("not captured"))
+	fmt.Println(stacktrace.Frames(err)[0].Goroutine)
+
+	// Output:
+	// worker failed true
+	// pipeline failed true
+	// true
+	// 0
+}
@@ -0,0 +1,40 @@
+package stacktrace_test
+
+import (
+	"fmt"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func countdown(n int) error {
+	if n == 0 {
+		return stacktrace.New("liftoff aborted")
+	}
+
+	return countdown(n - 1)
+}
+
+func ExampleSetCollapseRecursion() {
+	// By default, the stack is cut at the first repeated frame.
+	fmt.Println(stacktrace.Sprint(countdown(3)))
+	fmt.Println()
+
+	stacktrace.SetCollapseRecursion(true)
+	defer stacktrace.SetCollapseRecursion(false)
+
+	fmt.Println(stacktrace.Sprint(countdown(3)))
+
+	// Output:
+	// Error: liftoff aborted
+	//     Origin is: liftoff aborted
+	//         at stacktrace_test.countdown (in examples_set_collapse_recursion_test.go:11)
+	//     Ends here:
+	//         at stacktrace_test.countdown (in examples_set_collapse_recursion_test.go:14)
+	//
+	// Error: liftoff aborted
+	//     Origin is: liftoff aborted
+	//         at stacktrace_test.countdown (in examples_set_collapse_recursion_test.go:11)
+	//         at stacktrace_test.countdown (in examples_set_collapse_recursion_test.go:14) [repeated 3 times]
+	//     Ends here:
+	//         at stacktrace_test.ExampleSetCollapseRecursion (in examples_set_collapse_recursion_test.go:25)
+}
@@ -0,0 +1,29 @@
+package stacktrace_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleSetTrimPath() {
+	defer stacktrace.SetTrimPath(stacktrace.TrimWorkingDir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	// Trim up to the parent directory.
+	stacktrace.SetTrimPath(stacktrace.TrimPrefix(filepath.Dir(wd) + "/"))
+
+	err = stacktrace.Wrap(errors.New("disk full"))
+	fmt.Println(stacktrace.Sprint(err))
+
+	// Output:
+	// Error: disk full
+	//         at stacktrace_test.ExampleSetTrimPath (in stacktrace/examples_set_trim_path_test.go:23)
+}
@@ -0,0 +1,20 @@
+package stacktrace_test
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/alextanhongpin/errors/stacktrace"
+)
+
+func ExampleStackTrace() {
+	err := child()
+	for _, f := range stacktrace.StackTrace(err) {
+		fmt.Printf("%d %q %s:%d\n", f.ID, f.Cause, filepath.Base(f.File), f.Line)
+	}
+
+	// Output:
+	// 1 "" examples_stack_trace_test.go:11
+	// 2 "child" examples_frames_test.go:16
+	// 3 "root" examples_frames_test.go:11
+}
@@ -1,14 +1,31 @@
 package internal
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"runtime"
+	"strconv"
+	"sync/atomic"
 )
 
 // MaxDepth is configurable.
 var MaxDepth = 32
 
+// Verbose formats the error with its stacktrace for the %+v verb. It is set
+// by the stacktrace package, which owns the formatting.
+var Verbose = func(err error) string {
+	return err.Error()
+}
+
+// CaptureGoroutine records the goroutine id when enabled.
+var CaptureGoroutine atomic.Bool
+
+// KeepRecursion keeps the repeated frames of a recursive call within the same
+// stack when enabled. Otherwise the stack is cut at the first repeated frame.
+var KeepRecursion atomic.Bool
+
 func New(msg string, args ...any) error {
 	return newCaller(2, msg, args...)
 }
@@ -39,10 +56,11 @@ func newCaller(skip int, msg string, args ...any) error {
 	pc, _ := head(stack)
 
 	return &ErrorTrace{
-		err:   fmt.Errorf(msg, args...),
-		stack: stack, // Skips [New, caller]
-		cause: fmt.Sprintf(msg, args...),
-		pc:    pc,
+		err:       fmt.Errorf(msg, args...),
+		stack:     stack, // Skips [New, caller]
+		cause:     fmt.Sprintf(msg, args...),
+		pc:        pc,
+		goroutine: goroutine(),
 	}
 }
 
@@ -60,10 +78,11 @@ func wrapCaller(skip int, err error) error {
 	pc, _ := head(stack)
 
 	return &ErrorTrace{
-		err:   err,
-		stack: stack, // Skips [Wrap, caller]
-		cause: err.Error(),
-		pc:    pc,
+		err:       err,
+		stack:     stack, // Skips [Wrap, caller]
+		cause:     err.Error(),
+		pc:        pc,
+		goroutine: goroutine(),
 	}
 }
 
@@ -115,10 +134,11 @@ func annotateCaller(skip int, err error, cause string) *ErrorTrace {
 	}
 
 	return &ErrorTrace{
-		err:   err,
-		stack: stack,
-		cause: cause,
-		pc:    pc,
+		err:       err,
+		stack:     stack,
+		cause:     cause,
+		pc:        pc,
+		goroutine: goroutine(),
 	}
 }
 
@@ -131,6 +151,9 @@ type ErrorTrace struct {
 
 	// The PC containing the cause, it can be from previous errors.
 	pc uintptr
+
+	// The goroutine id where the stack is captured, if enabled.
+	goroutine uint64
 }
 
 func (e *ErrorTrace) StackTrace() []uintptr {
@@ -153,18 +176,50 @@ func (e *ErrorTrace) Unwrap() error {
 	return e.err
 }
 
+// Format implements fmt.Formatter. The %+v verb prints the error together
+// with the stacktrace, similar to github.com/pkg/errors.
+func (e *ErrorTrace) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			io.WriteString(f, Verbose(e))
+			return
+		}
+
+		fallthrough
+	case 's':
+		io.WriteString(f, e.Error())
+	case 'q':
+		fmt.Fprintf(f, "%q", e.Error())
+	}
+}
+
 func Reverse[T any](s []T) {
 	reverse(s)
 }
 
+// Goroutines returns the goroutine id of the stack that each PC belongs
+// to. It is empty unless CaptureGoroutine is enabled.
+func Goroutines(err error) map[uintptr]uint64 {
+	_, _, goroutines := unwrapAll(err)
+	return goroutines
+}
+
 func unwrap(err error) ([]uintptr, map[uintptr]string) {
+	pcs, cause, _ := unwrapAll(err)
+	return pcs, cause
+}
+
+func unwrapAll(err error) ([]uintptr, map[uintptr]string, map[uintptr]uint64) {
 	if err == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	var pcs []uintptr
 	cause := make(map[uintptr]string)
+	goroutines := make(map[uintptr]uint64)
 	seen := make(map[runtime.Frame]bool)
+	keep := KeepRecursion.Load()
 
 	for err != nil {
 		var t *ErrorTrace
@@ -178,6 +233,7 @@ func unwrap(err error) ([]uintptr, map[uintptr]string) {
 		}
 
 		var ordered []uintptr
+		local := make(map[runtime.Frame]bool)
 		frames := runtime.CallersFrames(t.StackTrace())
 		for {
 			f, more := frames.Next()
@@ -190,20 +246,28 @@ func unwrap(err error) ([]uintptr, map[uintptr]string) {
 				Function: f.Function,
 				Line:     f.Line,
 			}
-			if seen[key] {
+			if seen[key] || (!keep && local[key]) {
 				break
 			}
 
-			seen[key] = true
+			local[key] = true
 			// The runtime.CallersFrames PC =
 			// runtime.callers(skip) PC - 1
 			ordered = append(ordered, f.PC+1)
+			if t.goroutine != 0 {
+				goroutines[f.PC+1] = t.goroutine
+			}
 
 			if !more {
 				break
 			}
 		}
 
+		// Only frames from other stacks are treated as duplicates.
+		for key := range local {
+			seen[key] = true
+		}
+
 		// Stack is ordered from bottom-up.
 		// Reverse it so that it goes top-down.
 		reverse(ordered)
@@ -216,7 +280,7 @@ func unwrap(err error) ([]uintptr, map[uintptr]string) {
 	// runtime.callers will return, which is bottom-up.
 	reverse(pcs)
 
-	return pcs, cause
+	return pcs, cause, goroutines
 }
 
 func callers(skip int) []uintptr {
@@ -253,3 +317,19 @@ func head[T any](ts []T) (t T, ok bool) {
 
 	return
 }
+
+// goroutine returns the current goroutine id if CaptureGoroutine is enabled.
+func goroutine() uint64 {
+	if !CaptureGoroutine.Load() {
+		return 0
+	}
+
+	// The first line is in the format "goroutine 18 [running]:".
+	b := make([]byte, 64)
+	b = b[:runtime.Stack(b, false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	b, _, _ = bytes.Cut(b, []byte(" "))
+
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
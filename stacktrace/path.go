@@ -0,0 +1,86 @@
+package stacktrace
+
+import (
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+)
+
+// trimPath shortens the file paths in Sprint and Sprintln.
+var trimPath = TrimWorkingDir
+
+// SetTrimPath sets the function that shortens the file paths in Sprint and
+// Sprintln. Defaults to TrimWorkingDir. Frames always returns absolute
+// paths.
+func SetTrimPath(fn func(file string) string) {
+	trimPath = fn
+}
+
+// TrimWorkingDir trims the working directory of the process. This breaks
+// when the binary runs from a different directory than the source.
+func TrimWorkingDir(file string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return file
+	}
+
+	file = strings.TrimPrefix(file, wd)
+	return strings.TrimPrefix(file, "/")
+}
+
+// TrimModuleRoot trims everything up to the root of the main module, based
+// on the module path from the build info. This works for binaries built
+// with -trimpath, or checked out under a directory matching the module
+// path.
+func TrimModuleRoot(file string) string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok || bi.Main.Path == "" {
+		return file
+	}
+
+	_, after, ok := strings.Cut(file, bi.Main.Path+"/")
+	if !ok {
+		return file
+	}
+
+	return after
+}
+
+// TrimGOPATH trims the GOPATH source and module cache directories.
+func TrimGOPATH(file string) string {
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return file
+		}
+
+		gopath = filepath.Join(home, "go")
+	}
+
+	for _, dir := range filepath.SplitList(gopath) {
+		for _, prefix := range []string{
+			filepath.Join(dir, "src") + "/",
+			filepath.Join(dir, "pkg", "mod") + "/",
+		} {
+			if strings.HasPrefix(file, prefix) {
+				return strings.TrimPrefix(file, prefix)
+			}
+		}
+	}
+
+	return file
+}
+
+// TrimPrefix returns a function that trims the given prefix.
+func TrimPrefix(prefix string) func(file string) string {
+	return func(file string) string {
+		return strings.TrimPrefix(file, prefix)
+	}
+}
+
+// AbsolutePath keeps the absolute path, e.g. for click-through in IDEs.
+func AbsolutePath(file string) string {
+	return file
+}
@@ -1,8 +1,9 @@
 package stacktrace
 
 import (
+	"encoding/json"
 	"fmt"
-	"os"
+	"log/slog"
 	"path"
 	"regexp"
 	"runtime"
@@ -31,6 +32,12 @@ var SkipPattern = regexp.MustCompile(`^(runtime|testing|net|_)`)
 
 type ErrorTrace = internal.ErrorTrace
 
+func init() {
+	internal.Verbose = func(err error) string {
+		return strings.TrimSuffix(sprint(err, false), "\n")
+	}
+}
+
 func New(msg string, args ...any) error {
 	return internal.New(msg, args...)
 }
@@ -53,10 +60,51 @@ func SprintReversed(err error) string {
 	return sprint(err, true)
 }
 
+// Sprintln is like Sprint, but formats the error and its stacktrace on a
+// single line, for log pipelines that do not handle multi-line output.
+func Sprintln(err error) string {
+	return sprintln(err)
+}
+
+// Frames returns the frames of the error, starting from the origin, where
+// the error is created.
 func Frames(err error) []Frame {
 	return frames(err)
 }
 
+// StackTrace is like Frames, but returns the frames in call order, starting
+// from the outermost caller and ending at the origin, like a panic stack
+// read from the bottom. The IDs follow the call order.
+func StackTrace(err error) []Frame {
+	res := frames(err)
+	reverse(res)
+	for i := range res {
+		res[i].ID = i + 1
+	}
+
+	return res
+}
+
+// MarshalJSON returns the error message and the frames as JSON. Each frame
+// includes the role it plays in the error chain, one of "origin",
+// "caused_by" or "ends_here".
+func MarshalJSON(err error) ([]byte, error) {
+	if err == nil {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(errorJSON{
+		Error:  err.Error(),
+		Frames: roleFrames(err),
+	})
+}
+
+// LogValuer returns a slog.LogValuer that logs the error message together
+// with the frames as a structured array.
+func LogValuer(err error) slog.LogValuer {
+	return logValuer{err: err}
+}
+
 func Unwrap(err error) ([]uintptr, map[uintptr]string) {
 	return internal.Unwrap(err)
 }
@@ -65,18 +113,52 @@ func SetMaxDepth(depth int) {
 	internal.MaxDepth = depth
 }
 
+// SetCaptureGoroutine enables recording the goroutine id when New, Wrap or
+// Annotate is called. The id is included in the frames, to correlate traces
+// with goroutine dumps.
+func SetCaptureGoroutine(enabled bool) {
+	internal.CaptureGoroutine.Store(enabled)
+}
+
+// SetCollapseRecursion keeps the frames of recursive calls on the same line,
+// which are otherwise treated as duplicates and dropped. The repeated frames
+// are collapsed into one, with the number of repeats.
+func SetCollapseRecursion(enabled bool) {
+	internal.KeepRecursion.Store(enabled)
+}
+
 // Caller returns the common methods that depends on the
 // skip with configurable skip.
 func Caller(skip int) stacktraceToggler {
 	return internal.Caller(skip)
 }
 
+// Roles of a frame in the error chain.
+const (
+	RoleOrigin   = "origin"
+	RoleCausedBy = "caused_by"
+	RoleEndsHere = "ends_here"
+)
+
+var labelByRole = map[string]string{
+	RoleOrigin:   head,
+	RoleCausedBy: body,
+	RoleEndsHere: tail,
+}
+
 type Frame struct {
 	ID       int    `json:"id"`
 	Cause    string `json:"cause"`
 	File     string `json:"file"`
 	Line     int    `json:"line"`
 	Function string `json:"function"`
+
+	// Goroutine is only set when SetCaptureGoroutine is enabled.
+	Goroutine uint64 `json:"goroutine,omitempty"`
+
+	// Repeat is the number of consecutive calls collapsed into this frame,
+	// only set when SetCollapseRecursion is enabled.
+	Repeat int `json:"repeat,omitempty"`
 }
 
 func frames(err error) []Frame {
@@ -87,7 +169,8 @@ func frames(err error) []Frame {
 	var res []Frame
 
 	pcs, cause := Unwrap(err)
-	pcs = filterFrames(pcs)
+	pcs, repeat := collapse(filterFrames(pcs))
+	goroutines := internal.Goroutines(err)
 
 	var id int
 	frames := runtime.CallersFrames(pcs)
@@ -104,11 +187,13 @@ func frames(err error) []Frame {
 
 		msg, _ := cause[frame.PC+1]
 		res = append(res, Frame{
-			ID:       id,
-			Cause:    msg,
-			File:     frame.File,
-			Function: frame.Function,
-			Line:     frame.Line,
+			ID:        id,
+			Cause:     msg,
+			File:      frame.File,
+			Function:  frame.Function,
+			Line:      frame.Line,
+			Goroutine: goroutines[frame.PC+1],
+			Repeat:    repeat[frame.PC+1],
 		})
 		if !more {
 			break
@@ -118,6 +203,106 @@ func frames(err error) []Frame {
 	return res
 }
 
+type errorJSON struct {
+	Error  string      `json:"error"`
+	Frames []roleFrame `json:"frames"`
+}
+
+type logValuer struct {
+	err error
+}
+
+func (l logValuer) LogValue() slog.Value {
+	if l.err == nil {
+		return slog.Value{}
+	}
+
+	return slog.GroupValue(
+		slog.String("message", l.err.Error()),
+		slog.Any("frames", roleFrames(l.err)),
+	)
+}
+
+type roleFrame struct {
+	Role string `json:"role,omitempty"`
+	Frame
+}
+
+func roleFrames(err error) []roleFrame {
+	var res []roleFrame
+
+	pcs, cause := Unwrap(err)
+	pcs, repeat := collapse(filterFrames(pcs))
+	goroutines := internal.Goroutines(err)
+
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if !skipFrame(frame) {
+			res = append(res, roleFrame{
+				Role: role(pcs, cause, frame.PC+1),
+				Frame: Frame{
+					ID:        len(res) + 1,
+					Cause:     cause[frame.PC+1],
+					File:      frame.File,
+					Function:  frame.Function,
+					Line:      frame.Line,
+					Goroutine: goroutines[frame.PC+1],
+					Repeat:    repeat[frame.PC+1],
+				},
+			})
+		}
+		if !more {
+			break
+		}
+	}
+
+	return res
+}
+
+// role returns the role of the pc, following the same rules as prettyCause.
+func role(pcs []uintptr, cause map[uintptr]string, pc uintptr) string {
+	if len(pcs) < 2 {
+		return ""
+	}
+
+	switch pc {
+	case pcs[0]:
+		return RoleOrigin
+	case pcs[len(pcs)-1]:
+		return RoleEndsHere
+	}
+
+	if _, ok := cause[pc]; ok {
+		return RoleCausedBy
+	}
+
+	return ""
+}
+
+func sprintln(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	res := []string{"Error: " + err.Error()}
+	for _, f := range roleFrames(err) {
+		at := formatAt(f.Frame)
+
+		label, ok := labelByRole[f.Role]
+		switch {
+		case !ok:
+			res = append(res, at)
+		case f.Cause != "":
+			res = append(res, fmt.Sprintf("%s %s %s", label, f.Cause, at))
+		default:
+			res = append(res, fmt.Sprintf("%s %s", label, at))
+		}
+	}
+
+	return strings.Join(res, "; ")
+}
+
 func sprint(err error, reversed bool) string {
 	if err == nil {
 		return ""
@@ -131,8 +316,9 @@ func sprint(err error, reversed bool) string {
 	sb.WriteRune('\n')
 
 	pcs, cause := internal.Unwrap(err)
-	pcs = filterFrames(pcs)
+	pcs, repeat := collapse(filterFrames(pcs))
 	pcs, cause = prettyCause(pcs, cause)
+	goroutines := internal.Goroutines(err)
 	if reversed {
 		reverse(pcs)
 	}
@@ -156,7 +342,13 @@ func sprint(err error, reversed bool) string {
 		}
 		sb.WriteString(indent)
 		sb.WriteString(indent)
-		sb.WriteString(formatFrame(frame))
+		sb.WriteString(formatAt(Frame{
+			File:      frame.File,
+			Line:      frame.Line,
+			Function:  frame.Function,
+			Goroutine: goroutines[frame.PC+1],
+			Repeat:    repeat[frame.PC+1],
+		}))
 		if !more {
 			break
 		}
@@ -196,22 +388,44 @@ func skipFrame(f runtime.Frame) bool {
 		SkipPattern.MatchString(f.File)
 }
 
-func formatFrame(frame runtime.Frame) string {
-	return fmt.Sprintf("at %s (in %s:%d)",
-		prettyFunction(frame.Function),
-		prettyFile(frame.File),
-		frame.Line,
+func formatAt(f Frame) string {
+	s := fmt.Sprintf("at %s (in %s:%d)",
+		prettyFunction(f.Function),
+		prettyFile(f.File),
+		f.Line,
 	)
+	if f.Goroutine != 0 {
+		s = fmt.Sprintf("%s [goroutine %d]", s, f.Goroutine)
+	}
+	if f.Repeat != 0 {
+		s = fmt.Sprintf("%s [repeated %d times]", s, f.Repeat)
+	}
+
+	return s
 }
 
-func prettyFile(f string) string {
-	wd, err := os.Getwd()
-	if err != nil {
-		return f
+// collapse removes consecutive duplicate PCs, which are recursive calls on
+// the same line, and returns the number of calls for each collapsed PC.
+func collapse(pcs []uintptr) ([]uintptr, map[uintptr]int) {
+	var res []uintptr
+	repeat := make(map[uintptr]int)
+	for i, pc := range pcs {
+		if i == 0 || pc != pcs[i-1] {
+			res = append(res, pc)
+			continue
+		}
+
+		if repeat[pc] == 0 {
+			repeat[pc] = 1
+		}
+		repeat[pc]++
 	}
 
-	f = strings.TrimPrefix(f, wd)
-	return strings.TrimPrefix(f, "/")
+	return res, repeat
+}
+
+func prettyFile(f string) string {
+	return trimPath(f)
 }
 
 func prettyFunction(f string) string {